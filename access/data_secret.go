@@ -0,0 +1,44 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceSecret confirms, that secret exists in the scope, and exposes its metadata, but not the value
+func DataSourceSecret() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			scope := d.Get("scope").(string)
+			key := d.Get("key").(string)
+			secret, err := NewSecretsAPI(ctx, m).Read(scope, key)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			d.SetId(fmt.Sprintf("%s|||%s", scope, key))
+			if err = d.Set("last_updated_timestamp", secret.LastUpdatedTimestamp); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validScope,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validScope,
+			},
+			"last_updated_timestamp": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package access
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceSecretScopes lists all secret scopes of the workspace with their backend types
+func DataSourceSecretScopes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			scopes, err := NewSecretScopesAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			names := []string{}
+			items := []interface{}{}
+			for _, scope := range scopes {
+				item := map[string]interface{}{
+					"name":         scope.Name,
+					"backend_type": scope.BackendType,
+				}
+				if scope.KeyvaultMetadata != nil {
+					item["keyvault_metadata"] = []interface{}{
+						map[string]interface{}{
+							"resource_id": scope.KeyvaultMetadata.ResourceID,
+							"dns_name":    scope.KeyvaultMetadata.DNSName,
+						},
+					}
+				}
+				names = append(names, scope.Name)
+				items = append(items, item)
+			}
+			d.SetId("_")
+			if err = d.Set("names", names); err != nil {
+				return diag.FromErr(err)
+			}
+			if err = d.Set("scopes", items); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scopes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"keyvault_metadata": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"dns_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
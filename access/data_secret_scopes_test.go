@@ -0,0 +1,46 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceSecretScopes(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "application",
+							BackendType: "DATABRICKS",
+						},
+						{
+							Name:        "vault",
+							BackendType: "AZURE_KEYVAULT",
+							KeyvaultMetadata: &KeyvaultMetadata{
+								ResourceID: "/subscriptions/a/resourceGroups/b/providers/Microsoft.KeyVault/vaults/c",
+								DNSName:    "https://c.vault.azure.net/",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceSecretScopes(),
+		ID:          ".",
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"application", "vault"}, d.Get("names"))
+	assert.Equal(t, "DATABRICKS", d.Get("scopes.0.backend_type"))
+	assert.Equal(t, 0, d.Get("scopes.0.keyvault_metadata.#"))
+	assert.Equal(t, "AZURE_KEYVAULT", d.Get("scopes.1.backend_type"))
+	assert.Equal(t, "https://c.vault.azure.net/", d.Get("scopes.1.keyvault_metadata.0.dns_name"))
+}
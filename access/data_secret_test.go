@@ -0,0 +1,57 @@
+package access
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceSecret(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 12345678,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceSecret(),
+		ID:          ".",
+		HCL: `
+		scope = "foo"
+		key = "bar"`,
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "foo|||bar", d.Id())
+	assert.Equal(t, 12345678, d.Get("last_updated_timestamp"))
+}
+
+func TestDataSourceSecret_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceSecret(),
+		ID:          ".",
+		HCL: `
+		scope = "foo"
+		key = "bar"`,
+	}.ExpectError(t, "no secret Scope found with secret metadata scope name: foo and key: bar")
+}
@@ -2,6 +2,7 @@ package access
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"net/http"
 
@@ -117,6 +118,11 @@ func (a SecretsAPI) Read(scope string, key string) (SecretMetadata, error) {
 	}
 }
 
+// secretValueHash is kept in the state instead of cleartext value of write-only secret
+func secretValueHash(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))
+}
+
 // ResourceSecret manages secrets
 func ResourceSecret() *schema.Resource {
 	p := common.NewPairSeparatedID("scope", "key", "|||")
@@ -126,8 +132,15 @@ func ResourceSecret() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.StringIsNotEmpty,
 				Required:     true,
-				ForceNew:     true,
 				Sensitive:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// state of write-only secret keeps only hash of the value
+					return d.Get("write_only").(bool) && old == secretValueHash(new)
+				},
+			},
+			"write_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 			"scope": {
 				Type:         schema.TypeString,
@@ -152,7 +165,7 @@ func ResourceSecret() *schema.Resource {
 				return err
 			}
 			p.Pack(d)
-			return nil
+			return hideWriteOnlySecretValue(d)
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			scope, key, err := p.Unpack(d)
@@ -165,6 +178,17 @@ func ResourceSecret() *schema.Resource {
 			}
 			return d.Set("last_updated_timestamp", m.LastUpdatedTimestamp)
 		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			// secret value cannot be read back, so imported secrets are overwritten in place
+			scope, key, err := p.Unpack(d)
+			if err != nil {
+				return err
+			}
+			if err = NewSecretsAPI(ctx, c).Create(d.Get("string_value").(string), scope, key); err != nil {
+				return err
+			}
+			return hideWriteOnlySecretValue(d)
+		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			scope, key, err := p.Unpack(d)
 			if err != nil {
@@ -174,3 +198,11 @@ func ResourceSecret() *schema.Resource {
 		},
 	}.ToResource()
 }
+
+// hideWriteOnlySecretValue replaces cleartext value of write-only secret with its hash
+func hideWriteOnlySecretValue(d *schema.ResourceData) error {
+	if !d.Get("write_only").(bool) {
+		return nil
+	}
+	return d.Set("string_value", secretValueHash(d.Get("string_value").(string)))
+}
@@ -66,7 +66,7 @@ func (a SecretScopesAPI) Create(s SecretScope) error {
 			//lint:ignore ST1005 Azure is a valid capitalized string
 			return fmt.Errorf("Azure KeyVault is not available")
 		}
-		if a.client.AzureAuth.IsClientSecretSet() {
+		if a.client.AzureAuth.IsClientSecretSet() || a.client.AzureAuth.IsClientCertificateSet() {
 			//lint:ignore ST1005 Azure is a valid capitalized string
 			return fmt.Errorf("Azure KeyVault cannot yet be configured for Service Principal authorization")
 		}
@@ -123,7 +123,7 @@ func kvDiffFunc(ctx context.Context, diff *schema.ResourceDiff, v interface{}) e
 		return nil
 	}
 	client := v.(*common.DatabricksClient)
-	if client.IsAzure() && client.AzureAuth.IsClientSecretSet() {
+	if client.IsAzure() && (client.AzureAuth.IsClientSecretSet() || client.AzureAuth.IsClientCertificateSet()) {
 		return fmt.Errorf("you can't set up Azure KeyVault-based secret scope via Service Principal")
 	}
 	return nil
@@ -132,11 +132,15 @@ func kvDiffFunc(ctx context.Context, diff *schema.ResourceDiff, v interface{}) e
 // ResourceSecretScope manages secret scopes
 func ResourceSecretScope() *schema.Resource {
 	s := common.StructToSchema(SecretScope{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		// TODO: DiffSuppressFunc for initial_manage_principal & importing
 		s["name"].ForceNew = true
 		// nolint
 		s["name"].ValidateFunc = validScope
 		s["initial_manage_principal"].ForceNew = true
+		s["initial_manage_principal"].ValidateFunc = validation.StringInSlice([]string{"users"}, false)
+		// API doesn't return initial_manage_principal, so imported scopes have it empty
+		s["initial_manage_principal"].DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+			return old == "" && d.Id() != ""
+		}
 		s["keyvault_metadata"].ForceNew = true
 
 		return s
@@ -161,7 +165,19 @@ func ResourceSecretScope() *schema.Resource {
 			if err != nil {
 				return err
 			}
-			return common.StructToData(scope, s, d)
+			if err = common.StructToData(scope, s, d); err != nil {
+				return err
+			}
+			// StructToData skips empty or not configured blocks, but backend change
+			// between Databricks and Azure Key Vault has to show up as a drift
+			keyvaultMetadata := []interface{}{}
+			if scope.KeyvaultMetadata != nil {
+				keyvaultMetadata = append(keyvaultMetadata, map[string]interface{}{
+					"resource_id": scope.KeyvaultMetadata.ResourceID,
+					"dns_name":    scope.KeyvaultMetadata.DNSName,
+				})
+			}
+			return d.Set("keyvault_metadata", keyvaultMetadata)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewSecretScopesAPI(ctx, c).Delete(d.Id())
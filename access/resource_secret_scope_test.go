@@ -254,7 +254,7 @@ func TestResourceSecretScopeCreate_Error(t *testing.T) {
 		},
 		Resource: ResourceSecretScope(),
 		State: map[string]interface{}{
-			"initial_manage_principal": "groups",
+			"initial_manage_principal": "users",
 			"name":                     "Boom",
 		},
 		Create: true,
@@ -263,6 +263,83 @@ func TestResourceSecretScopeCreate_Error(t *testing.T) {
 	assert.Equal(t, "", d.Id(), "Id should be empty for error creates")
 }
 
+func TestResourceSecretScopeCreate_InvalidPrincipal(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceSecretScope(),
+		State: map[string]interface{}{
+			"initial_manage_principal": "groups",
+			"name":                     "Boom",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [initial_manage_principal] "+
+		"expected initial_manage_principal to be one of [users], got groups")
+}
+
+func TestResourceSecretScopeRead_BackendChangedToDatabricks(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "abc",
+							BackendType: "DATABRICKS",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		State: map[string]interface{}{
+			"name":         "abc",
+			"backend_type": "AZURE_KEYVAULT",
+			"keyvault_metadata": []interface{}{
+				map[string]interface{}{
+					"resource_id": "bcd",
+					"dns_name":    "def",
+				},
+			},
+		},
+		Read: true,
+		ID:   "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "DATABRICKS", d.Get("backend_type"))
+	assert.Equal(t, 0, d.Get("keyvault_metadata.#"))
+}
+
+func TestResourceSecretScopeRead_KeyVaultNotConfigured(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/secrets/scopes/list",
+				Response: SecretScopeList{
+					Scopes: []SecretScope{
+						{
+							Name:        "abc",
+							BackendType: "AZURE_KEYVAULT",
+							KeyvaultMetadata: &KeyvaultMetadata{
+								ResourceID: "bcd",
+								DNSName:    "def",
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecretScope(),
+		Read:     true,
+		ID:       "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "AZURE_KEYVAULT", d.Get("backend_type"))
+	assert.Equal(t, "bcd", d.Get("keyvault_metadata.0.resource_id"))
+	assert.Equal(t, "def", d.Get("keyvault_metadata.0.dns_name"))
+}
+
 func TestResourceSecretScopeDelete(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -336,3 +413,13 @@ func TestKVDiffFuncSPN(t *testing.T) {
 		Create: true,
 	}.ExpectError(t, "you can't set up Azure KeyVault-based secret scope via Service Principal")
 }
+
+func TestInitialManagePrincipalSuppressedOnImport(t *testing.T) {
+	r := ResourceSecretScope()
+	suppress := r.Schema["initial_manage_principal"].DiffSuppressFunc
+	d := r.TestResourceData()
+	assert.False(t, suppress("initial_manage_principal", "", "users", d))
+	d.SetId("abc")
+	assert.True(t, suppress("initial_manage_principal", "", "users", d))
+	assert.False(t, suppress("initial_manage_principal", "users", "", d))
+}
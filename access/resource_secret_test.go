@@ -116,6 +116,7 @@ func TestResourceSecretCreate(t *testing.T) {
 	}.Apply(t)
 	assert.NoError(t, err, err)
 	assert.Equal(t, "foo|||bar", d.Id())
+	assert.Equal(t, "SparkIsTh3Be$t", d.Get("string_value"))
 }
 
 func TestResourceSecretCreate_Error(t *testing.T) {
@@ -183,3 +184,97 @@ func TestResourceSecretDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "foo|||bar", d.Id())
 }
+
+func TestResourceSecretUpdate_Imported(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "SparkIsTh3Be$t",
+					Scope:       "foo",
+					Key:         "bar",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 12345678,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecret(),
+		InstanceState: map[string]string{
+			"scope":                  "foo",
+			"key":                    "bar",
+			"last_updated_timestamp": "12345",
+		},
+		HCL: `
+		scope = "foo"
+		key = "bar"
+		string_value = "SparkIsTh3Be$t"
+		`,
+		Update: true,
+		ID:     "foo|||bar",
+	}.ApplyNoError(t)
+}
+
+func TestResourceSecretCreate_WriteOnly(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/secrets/put",
+				ExpectedRequest: SecretsRequest{
+					StringValue: "SparkIsTh3Be$t",
+					Scope:       "foo",
+					Key:         "bar",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/secrets/list?scope=foo",
+				Response: SecretsList{
+					Secrets: []SecretMetadata{
+						{
+							Key:                  "bar",
+							LastUpdatedTimestamp: 12345678,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceSecret(),
+		HCL: `
+		scope = "foo"
+		key = "bar"
+		string_value = "SparkIsTh3Be$t"
+		write_only = true
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	state := d.State().Attributes
+	assert.Equal(t, secretValueHash("SparkIsTh3Be$t"), state["string_value"])
+	assert.NotContains(t, state["string_value"], "SparkIsTh3Be$t")
+}
+
+func TestResourceSecretWriteOnlySuppressesHashDiff(t *testing.T) {
+	r := ResourceSecret()
+	suppress := r.Schema["string_value"].DiffSuppressFunc
+	hash := secretValueHash("SparkIsTh3Be$t")
+
+	d := r.TestResourceData()
+	assert.False(t, suppress("string_value", hash, "SparkIsTh3Be$t", d))
+
+	assert.NoError(t, d.Set("write_only", true))
+	assert.True(t, suppress("string_value", hash, "SparkIsTh3Be$t", d))
+	assert.False(t, suppress("string_value", hash, "changed", d))
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// auditRecord is a single line of audit log, written for every mutating API call
+type auditRecord struct {
+	Timestamp  string      `json:"timestamp"`
+	Method     string      `json:"method"`
+	Path       string      `json:"path"`
+	Resource   string      `json:"resource,omitempty"`
+	ResourceID string      `json:"resource_id,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// auditLog appends redacted JSON line about mutating API call to audit_log_path,
+// so that changes made during apply could be reviewed later
+func (c *DatabricksClient) auditLog(ctx context.Context, method, path string, requestBody []byte, callErr error) {
+	if c.AuditLogPath == "" || method == "GET" {
+		return
+	}
+	record := auditRecord{
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		Method:    method,
+		Path:      path,
+	}
+	if rn, ok := ctx.Value(ResourceName).(string); ok {
+		record.Resource = "databricks_" + rn
+	}
+	if id, ok := ctx.Value(ResourceID).(string); ok {
+		record.ResourceID = id
+	}
+	if len(requestBody) > 0 {
+		var body interface{}
+		if err := json.Unmarshal(requestBody, &body); err == nil {
+			record.Body = c.maskValue("", body)
+		}
+	}
+	if callErr != nil {
+		record.Error = callErr.Error()
+	}
+	if err := c.writeAuditRecord(record); err != nil {
+		log.Printf("[WARN] Cannot write audit log: %s", err)
+	}
+}
+
+func (c *DatabricksClient) writeAuditRecord(record auditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	path, err := homedir.Expand(c.AuditLogPath)
+	if err != nil {
+		return err
+	}
+	c.auditMutex.Lock()
+	defer c.auditMutex.Unlock()
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = fmt.Fprintf(file, "%s\n", line)
+	return err
+}
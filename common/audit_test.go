@@ -0,0 +1,118 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/api/2.0/secrets/put" {
+				rw.WriteHeader(400)
+				_, err := rw.Write([]byte(`{"error_code": "INVALID_PARAMETER_VALUE", "message": "nope"}`))
+				assert.NoError(t, err)
+				return
+			}
+			_, err := rw.Write([]byte(`{}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	auditLog := filepath.Join(t.TempDir(), "audit.log")
+	client := DatabricksClient{
+		Host:         server.URL,
+		Token:        "..",
+		AuditLogPath: auditLog,
+	}
+	require.NoError(t, client.Configure())
+	ctx := context.WithValue(context.Background(), ResourceName, "cluster")
+	ctx = context.WithValue(ctx, ResourceID, "abc")
+
+	err := client.Get(ctx, "/clusters/get", map[string]string{"cluster_id": "abc"}, nil)
+	require.NoError(t, err)
+	err = client.Post(ctx, "/clusters/edit", map[string]string{"cluster_id": "abc"}, nil)
+	require.NoError(t, err)
+	err = client.Post(context.Background(), "/secrets/put", map[string]string{
+		"scope":        "a",
+		"string_value": "secret",
+	}, nil)
+	require.Error(t, err)
+
+	raw, err := ioutil.ReadFile(auditLog)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, 2, "GET requests must not be logged")
+
+	var edit auditRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &edit))
+	assert.NotEmpty(t, edit.Timestamp)
+	assert.Equal(t, "POST", edit.Method)
+	assert.Equal(t, "/api/2.0/clusters/edit", edit.Path)
+	assert.Equal(t, "databricks_cluster", edit.Resource)
+	assert.Equal(t, "abc", edit.ResourceID)
+	assert.Equal(t, map[string]interface{}{"cluster_id": "abc"}, edit.Body)
+
+	var put auditRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &put))
+	assert.Equal(t, "", put.Resource)
+	assert.Equal(t, "nope", put.Error)
+	assert.Equal(t, map[string]interface{}{
+		"scope":        "a",
+		"string_value": "**REDACTED**",
+	}, put.Body)
+}
+
+func TestAuditLog_RedactsNotificationDestinationConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			_, err := rw.Write([]byte(`{"id": "abc"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	auditLog := filepath.Join(t.TempDir(), "audit.log")
+	client := DatabricksClient{
+		Host:         server.URL,
+		Token:        "..",
+		AuditLogPath: auditLog,
+	}
+	require.NoError(t, client.Configure())
+
+	err := client.Post(context.Background(), "/notification-destinations", map[string]interface{}{
+		"display_name": "alerts",
+		"config": map[string]interface{}{
+			"slack": map[string]string{
+				"url": "https://hooks.slack.com/services/T000/B000/XXXX",
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	err = client.Patch(context.Background(), "/notification-destinations/abc", map[string]interface{}{
+		"pagerduty": map[string]string{
+			"integration_key": "pagerduty-key",
+		},
+	})
+	require.NoError(t, err)
+
+	raw, err := ioutil.ReadFile(auditLog)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "hooks.slack.com")
+	assert.NotContains(t, string(raw), "pagerduty-key")
+
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	require.Len(t, lines, 2)
+	var create auditRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &create))
+	assert.Equal(t, map[string]interface{}{
+		"display_name": "alerts",
+		"config":       "**REDACTED**",
+	}, create.Body)
+}
@@ -22,6 +22,14 @@ const (
 	AzureDatabricksResourceID string = "2ff814a6-3304-4ab8-85cb-cd0e6f879c1d"
 )
 
+// azureDatabricksHostSuffixes are domains of Azure Databricks workspaces in public,
+// US Government and China clouds
+var azureDatabricksHostSuffixes = []string{
+	".azuredatabricks.net",
+	".databricks.azure.us",
+	".databricks.azure.cn",
+}
+
 // AzureAuth contains all the auth information for azure sp authentication
 type AzureAuth struct {
 	WorkspaceName  string
@@ -36,6 +44,20 @@ type AzureAuth struct {
 	TenantID     string
 	Environment  string
 
+	// PFX certificate of the service principal, when client secrets are not allowed
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+
+	// use Azure Managed Service Identity of the VM/AKS/App Service
+	UseMSI bool
+
+	// exchange OIDC token of CI pipeline for AAD token via federated credentials
+	UseOIDC           bool
+	OIDCToken         string
+	OIDCTokenFilePath string
+	OIDCRequestURL    string
+	OIDCRequestToken  string
+
 	// temporary workaround for SP-based auth
 	PATTokenDurationSeconds string
 	UsePATForCLI            bool
@@ -59,6 +81,19 @@ type tokenResponse struct {
 	TokenInfo  *tokenInfo `json:"token_info,omitempty"`
 }
 
+// patRefreshMargin is the time before expiry of the temporary PAT, when new one is minted
+const patRefreshMargin = 5 * time.Minute
+
+// expiresSoon returns true, if the token is about to expire. Tokens without
+// known expiry time are considered to never expire.
+func (tr *tokenResponse) expiresSoon() bool {
+	if tr.TokenInfo == nil || tr.TokenInfo.ExpiryTime <= 0 {
+		return false
+	}
+	expiry := time.Unix(0, tr.TokenInfo.ExpiryTime*int64(time.Millisecond))
+	return time.Now().Add(patRefreshMargin).After(expiry)
+}
+
 // tokenInfo is a struct that contains metadata about a given token
 type tokenInfo struct {
 	TokenID      string `json:"token_id,omitempty"`
@@ -211,15 +246,17 @@ func (aa *AzureAuth) acquirePAT(
 	ctx context.Context,
 	factory func(resource string) (autorest.Authorizer, error),
 	visitors ...func(r *http.Request, ma autorest.Authorizer) error) (*tokenResponse, error) {
-	if aa.temporaryPat != nil {
-		// todo: add IsExpired
+	if aa.temporaryPat != nil && !aa.temporaryPat.expiresSoon() {
 		return aa.temporaryPat, nil
 	}
 	authorizerMutex.Lock()
 	defer authorizerMutex.Unlock()
-	if aa.temporaryPat != nil {
+	if aa.temporaryPat != nil && !aa.temporaryPat.expiresSoon() {
 		return aa.temporaryPat, nil
 	}
+	if aa.temporaryPat != nil {
+		log.Printf("[INFO] Temporary PAT expires soon, generating new one")
+	}
 	env, err := aa.getAzureEnvironment()
 	if err != nil {
 		return nil, err
@@ -319,6 +356,10 @@ func (aa *AzureAuth) ensureWorkspaceURL(ctx context.Context,
 	if err != nil {
 		return err
 	}
+	if workspace.Properties.WorkspaceURL == "" {
+		return fmt.Errorf("workspace %s has no workspaceUrl yet, provisioning state: %s",
+			resourceID, workspace.Properties.ProvisioningState)
+	}
 	aa.databricksClient.Host = fmt.Sprintf("https://%s/", workspace.Properties.WorkspaceURL)
 	return nil
 }
@@ -355,7 +396,16 @@ func (aa *AzureAuth) getClientSecretAuthorizer(resource string) (autorest.Author
 			},
 			Environment: env,
 		}
-		return es.GetAuthorizer()
+		ccc, err := es.GetClientCredentials()
+		if err != nil {
+			return nil, err
+		}
+		spt, err := ccc.ServicePrincipalToken()
+		if err != nil {
+			return nil, err
+		}
+		aa.withHTTPClient(spt)
+		return autorest.NewBearerAuthorizer(spt), nil
 	}
 	platformTokenOAuthCfg, err := adal.NewOAuthConfigWithAPIVersion(
 		env.ActiveDirectoryEndpoint,
@@ -372,9 +422,19 @@ func (aa *AzureAuth) getClientSecretAuthorizer(resource string) (autorest.Author
 	if err != nil {
 		return nil, maybeExtendAuthzError(err)
 	}
+	aa.withHTTPClient(spt)
 	return autorest.NewBearerAuthorizer(spt), nil
 }
 
+// withHTTPClient makes AAD token requests to go through the same proxy
+// and TLS settings, as all other requests of the provider
+func (aa *AzureAuth) withHTTPClient(spt *adal.ServicePrincipalToken) {
+	if aa.databricksClient == nil || aa.databricksClient.httpClient == nil {
+		return
+	}
+	spt.SetSender(aa.databricksClient.httpClient.HTTPClient)
+}
+
 type azureDatabricksWorkspace struct {
 	Name string `json:"name"`
 	ID   string `json:"id"`
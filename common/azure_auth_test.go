@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
@@ -138,6 +139,35 @@ func TestAcquirePAT_CornerCases(t *testing.T) {
 	assert.Equal(t, "...", auth.TokenValue)
 }
 
+func TestAcquirePAT_ExpiredIsRefreshed(t *testing.T) {
+	aa := AzureAuth{
+		temporaryPat: &tokenResponse{
+			TokenValue: "...",
+			TokenInfo: &tokenInfo{
+				ExpiryTime: time.Now().Add(time.Minute).UnixNano() / int64(time.Millisecond),
+			},
+		},
+	}
+	// expired token is not reused, so new one has to be minted
+	_, err := aa.acquirePAT(context.Background(), func(resource string) (autorest.Authorizer, error) {
+		return &autorest.BearerAuthorizer{}, nil
+	})
+	assert.EqualError(t, err, "DatabricksClient is not configured")
+
+	aa.temporaryPat.TokenInfo.ExpiryTime = time.Now().Add(time.Hour).UnixNano() / int64(time.Millisecond)
+	pat, err := aa.acquirePAT(context.Background(), func(resource string) (autorest.Authorizer, error) {
+		return &autorest.BearerAuthorizer{}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "...", pat.TokenValue)
+}
+
+func TestTokenResponse_ExpiresSoon(t *testing.T) {
+	assert.False(t, (&tokenResponse{}).expiresSoon())
+	assert.False(t, (&tokenResponse{TokenInfo: &tokenInfo{ExpiryTime: -1}}).expiresSoon())
+	assert.True(t, (&tokenResponse{TokenInfo: &tokenInfo{ExpiryTime: 20}}).expiresSoon())
+}
+
 func TestAzureAuth_ensureWorkspaceURL(t *testing.T) {
 	aa := AzureAuth{}
 
@@ -165,7 +195,7 @@ func TestAzureAuth_ensureWorkspaceURL(t *testing.T) {
 	aa.azureManagementEndpoint = fmt.Sprintf("%s/", server.URL)
 
 	client := DatabricksClient{InsecureSkipVerify: true}
-	client.configureHTTPCLient()
+	require.NoError(t, client.configureHTTPCLient())
 	aa.databricksClient = &client
 	client.AzureAuth = aa
 
@@ -184,6 +214,28 @@ func TestAzureAuth_ensureWorkspaceURL(t *testing.T) {
 		"Calls to Azure Management API must be done only once")
 }
 
+func TestAzureAuth_ensureWorkspaceURL_NotProvisioned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			_, err := rw.Write([]byte(`{"properties": {"provisioningState": "Accepted"}}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+
+	client := DatabricksClient{}
+	require.NoError(t, client.configureHTTPCLient())
+	aa := AzureAuth{
+		ResourceID:              "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/workspaces/c",
+		azureManagementEndpoint: server.URL,
+		databricksClient:        &client,
+	}
+	authorizer := autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "x"})
+	err := aa.ensureWorkspaceURL(context.Background(), authorizer)
+	assert.EqualError(t, err, "workspace /subscriptions/a/resourceGroups/b/providers/"+
+		"Microsoft.Databricks/workspaces/c has no workspaceUrl yet, provisioning state: Accepted")
+	assert.Equal(t, "", client.Host)
+}
+
 func TestAzureAuth_configureWithClientSecretPAT(t *testing.T) {
 	aa := AzureAuth{}
 	auth, err := aa.configureWithClientSecret()
@@ -421,3 +473,31 @@ func TestMaybeExtendError(t *testing.T) {
 	err2 = maybeExtendAuthzError(err)
 	assert.True(t, strings.HasPrefix(err2.Error(), msg), err2.Error())
 }
+
+func TestGetClientSecretAuthorizer_HTTPProxy(t *testing.T) {
+	proxied := []string{}
+	proxy := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			// AAD is called over HTTPS, so proxy gets CONNECT request
+			proxied = append(proxied, fmt.Sprintf("%s %s", req.Method, req.Host))
+			rw.WriteHeader(http.StatusForbidden)
+		}))
+	defer proxy.Close()
+
+	client := DatabricksClient{
+		HTTPProxy: proxy.URL,
+		AzureAuth: AzureAuth{
+			TenantID:     "a",
+			ClientID:     "b",
+			ClientSecret: "c",
+		},
+	}
+	require.NoError(t, client.Configure())
+	auth, err := client.AzureAuth.getClientSecretAuthorizer("https://management.core.windows.net/")
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/a/b/c", http.NoBody)
+	_, err = autorest.Prepare(r, auth.WithAuthorization())
+	require.Error(t, err)
+	assert.Equal(t, []string{"CONNECT login.microsoftonline.com:443"}, proxied)
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/mitchellh/go-homedir"
+)
+
+// IsClientCertificateSet returns true if client id, certificate and tenant id are supplied
+func (aa *AzureAuth) IsClientCertificateSet() bool {
+	return aa.ClientID != "" && aa.ClientCertificatePath != "" && aa.TenantID != ""
+}
+
+// configureWithClientCertificate authenticates Azure Service Principal with PFX certificate,
+// for tenants, where client secrets are not allowed
+func (aa *AzureAuth) configureWithClientCertificate() (func(r *http.Request) error, error) {
+	if aa.databricksClient != nil && !aa.databricksClient.IsAzure() {
+		return nil, nil
+	}
+	if !aa.IsClientCertificateSet() {
+		return nil, nil
+	}
+	// fail early on wrong path or password instead of the first API call
+	if _, _, err := aa.clientCertificate(); err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] Using Azure Service Principal client certificate authentication")
+	return aa.simpleAADRequestVisitor(context.TODO(), aa.getClientCertificateAuthorizer, aa.addSpManagementTokenVisitor)
+}
+
+func (aa *AzureAuth) getClientCertificateAuthorizer(resource string) (autorest.Authorizer, error) {
+	if aa.authorizer != nil {
+		return aa.authorizer, nil
+	}
+	env, err := aa.getAzureEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, aa.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	certificate, privateKey, err := aa.clientCertificate()
+	if err != nil {
+		return nil, err
+	}
+	spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, aa.ClientID,
+		certificate, privateKey, resource)
+	if err != nil {
+		return nil, maybeExtendAuthzError(err)
+	}
+	aa.withHTTPClient(spt)
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+func (aa *AzureAuth) clientCertificate() (*x509.Certificate, *rsa.PrivateKey, error) {
+	path, err := homedir.Expand(aa.ClientCertificatePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	pfx, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot read client certificate: %w", err)
+	}
+	certificate, privateKey, err := adal.DecodePfxCertificateData(pfx, aa.ClientCertificatePassword)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot decode client certificate: %w", err)
+	}
+	return certificate, privateKey, nil
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureWithClientCertificate_NotUsed(t *testing.T) {
+	aa := AzureAuth{}
+	auth, err := aa.configureWithClientCertificate()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+
+	aa = AzureAuth{
+		ClientID:              "a",
+		TenantID:              "b",
+		ClientCertificatePath: "testdata/azure_sp.pfx",
+		databricksClient:      &DatabricksClient{Host: "https://abc.cloud.databricks.com/"},
+	}
+	auth, err = aa.configureWithClientCertificate()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+}
+
+func TestConfigureWithClientCertificate(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://adb-123.4.azuredatabricks.net/",
+		AuthType: "azure-client-certificate",
+		AzureAuth: AzureAuth{
+			ClientID:                  "a",
+			TenantID:                  "b",
+			ClientCertificatePath:     "testdata/azure_sp.pfx",
+			ClientCertificatePassword: "secret",
+		},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, dc.authVisitor)
+
+	authorizer, err := dc.AzureAuth.getClientCertificateAuthorizer(AzureDatabricksResourceID)
+	require.NoError(t, err)
+	_, ok := authorizer.(*autorest.BearerAuthorizer)
+	assert.True(t, ok)
+}
+
+func TestConfigureWithClientCertificate_WrongPassword(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host: "https://adb-123.4.azuredatabricks.net/",
+		AzureAuth: AzureAuth{
+			ClientID:                  "a",
+			TenantID:                  "b",
+			ClientCertificatePath:     "testdata/azure_sp.pfx",
+			ClientCertificatePassword: "wrong",
+		},
+	})
+	AssertErrorStartsWith(t, err, "cannot decode client certificate")
+}
+
+func TestConfigureWithClientCertificate_Missing(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host: "https://adb-123.4.azuredatabricks.net/",
+		AzureAuth: AzureAuth{
+			ClientID:              "a",
+			TenantID:              "b",
+			ClientCertificatePath: "testdata/missing.pfx",
+		},
+	})
+	AssertErrorStartsWith(t, err, "cannot read client certificate")
+}
+
+func TestConfigureWithClientCertificate_Visitor(t *testing.T) {
+	aa := AzureAuth{
+		ClientID:                  "a",
+		TenantID:                  "b",
+		ClientCertificatePath:     "testdata/azure_sp.pfx",
+		ClientCertificatePassword: "secret",
+		ResourceID:                "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/workspaces/c",
+		authorizer: autorest.NewBearerAuthorizer(&adal.Token{
+			AccessToken: "TestToken",
+			Resource:    "https://azure.microsoft.com/",
+			Type:        "Bearer",
+		}),
+		databricksClient: &DatabricksClient{Host: "https://adb-123.4.azuredatabricks.net/"},
+	}
+	auth, err := aa.configureWithClientCertificate()
+	require.NoError(t, err)
+
+	r, err := http.NewRequest("GET", "https://adb-123.4.azuredatabricks.net/api/2.0/clusters/list", nil)
+	require.NoError(t, err)
+	err = auth(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer TestToken", r.Header.Get("Authorization"))
+	assert.Equal(t, "TestToken", r.Header.Get("X-Databricks-Azure-SP-Management-Token"))
+	assert.Equal(t, aa.ResourceID, r.Header.Get("X-Databricks-Azure-Workspace-Resource-Id"))
+}
@@ -102,7 +102,7 @@ func (aa *AzureAuth) configureWithAzureCLI() (func(r *http.Request) error, error
 	if !aa.databricksClient.IsAzure() {
 		return nil, nil
 	}
-	if aa.IsClientSecretSet() {
+	if (aa.IsClientSecretSet() || aa.IsClientCertificateSet() || aa.UseMSI || aa.UseOIDC) && aa.databricksClient.AuthType != "azure-cli" {
 		return nil, nil
 	}
 	// verify that Azure CLI is authenticated
@@ -126,5 +126,5 @@ func (aa *AzureAuth) configureWithAzureCLI() (func(r *http.Request) error, error
 		}, nil
 	}
 	log.Printf("[INFO] Using Azure CLI authentication with AAD tokens")
-	return aa.simpleAADRequestVisitor(context.TODO(), aa.cliAuthorizer)
+	return aa.simpleAADRequestVisitor(context.TODO(), aa.cliAuthorizer, aa.addSpManagementTokenVisitor)
 }
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+// configureWithMSI uses Azure Managed Service Identity of the VM, AKS pod, App Service
+// or Cloud Shell, where the provider is running. Endpoint is discovered by ADAL
+// from MSI_ENDPOINT & MSI_SECRET environment variables, falling back to IMDS.
+func (aa *AzureAuth) configureWithMSI() (func(r *http.Request) error, error) {
+	if aa.databricksClient != nil && !aa.databricksClient.IsAzure() {
+		return nil, nil
+	}
+	if !aa.UseMSI {
+		return nil, nil
+	}
+	log.Printf("[INFO] Using Azure Managed Service Identity authentication")
+	if aa.UsePATForSPN {
+		log.Printf("[INFO] Generating PAT token for Azure Managed Service Identity authentication")
+		return func(r *http.Request) error {
+			pat, err := aa.acquirePAT(r.Context(), aa.getMsiAuthorizer, aa.addSpManagementTokenVisitor)
+			if err != nil {
+				return err
+			}
+			r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", pat.TokenValue))
+			return nil
+		}, nil
+	}
+	log.Printf("[INFO] Generating AAD token for Azure Managed Service Identity")
+	return aa.simpleAADRequestVisitor(context.TODO(), aa.getMsiAuthorizer, aa.addSpManagementTokenVisitor)
+}
+
+func (aa *AzureAuth) getMsiAuthorizer(resource string) (autorest.Authorizer, error) {
+	if aa.authorizer != nil {
+		return aa.authorizer, nil
+	}
+	// when azure_client_id is set, it refers to user-assigned identity
+	spt, err := adal.NewServicePrincipalTokenFromManagedIdentity(resource,
+		&adal.ManagedIdentityOptions{
+			ClientID: aa.ClientID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get MSI token for %s: %w", resource, err)
+	}
+	return autorest.NewBearerAuthorizer(spt), nil
+}
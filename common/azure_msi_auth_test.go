@@ -0,0 +1,74 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureWithMSI_NotUsed(t *testing.T) {
+	aa := AzureAuth{}
+	auth, err := aa.configureWithMSI()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+
+	aa = AzureAuth{
+		UseMSI:           true,
+		databricksClient: &DatabricksClient{Host: "https://abc.cloud.databricks.com/"},
+	}
+	auth, err = aa.configureWithMSI()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+}
+
+func TestAzureMsiAuth(t *testing.T) {
+	defer CleanupEnvironment()()
+	expiresOn := time.Now().Add(1 * time.Hour).Unix()
+	msi := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			require.NoError(t, req.ParseForm())
+			resource := req.Form.Get("resource")
+			_, err := rw.Write([]byte(fmt.Sprintf(`{
+				"access_token": "%s-token",
+				"expires_in": "3600",
+				"expires_on": "%d",
+				"resource": "%s",
+				"token_type": "Bearer"
+			}`, resource, expiresOn, resource)))
+			assert.NoError(t, err)
+		}))
+	defer msi.Close()
+	// MSI_ENDPOINT without MSI_SECRET is treated as Cloud Shell endpoint
+	os.Setenv("MSI_ENDPOINT", msi.URL)
+
+	client := DatabricksClient{
+		Host: "https://adb-123.4.azuredatabricks.net/",
+		AzureAuth: AzureAuth{
+			ResourceID: "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/workspaces/c",
+			UseMSI:     true,
+		},
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+
+	visitor, err := client.AzureAuth.configureWithMSI()
+	require.NoError(t, err)
+	require.NotNil(t, visitor)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	r = r.WithContext(context.Background())
+	err = visitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer "+AzureDatabricksResourceID+"-token", r.Header.Get("Authorization"))
+	assert.Equal(t, "https://management.core.windows.net/-token",
+		r.Header.Get("X-Databricks-Azure-SP-Management-Token"))
+	assert.Equal(t, client.AzureAuth.ResourceID,
+		r.Header.Get("X-Databricks-Azure-Workspace-Resource-Id"))
+}
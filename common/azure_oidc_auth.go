@@ -0,0 +1,132 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+// audience of OIDC tokens, that Azure AD accepts for federated credentials
+const azureOIDCAudience = "api://AzureADTokenExchange"
+
+// configureWithOIDC exchanges OIDC token of GitHub Actions or Kubernetes service account
+// for AAD tokens of the application with federated credentials, so that no long-lived
+// client secret has to be stored anywhere.
+func (aa *AzureAuth) configureWithOIDC() (func(r *http.Request) error, error) {
+	if aa.databricksClient != nil && !aa.databricksClient.IsAzure() {
+		return nil, nil
+	}
+	if !aa.UseOIDC {
+		return nil, nil
+	}
+	if aa.ClientID == "" || aa.TenantID == "" {
+		return nil, fmt.Errorf("azure_client_id and azure_tenant_id are required for OIDC authentication")
+	}
+	log.Printf("[INFO] Using Azure federated credentials with OIDC token")
+	return aa.simpleAADRequestVisitor(context.TODO(), aa.getOIDCAuthorizer, aa.addSpManagementTokenVisitor)
+}
+
+func (aa *AzureAuth) getOIDCAuthorizer(resource string) (autorest.Authorizer, error) {
+	if aa.authorizer != nil {
+		return aa.authorizer, nil
+	}
+	env, err := aa.getAzureEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, aa.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	spt, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig, aa.ClientID, resource,
+		&oidcClientAssertion{aa})
+	if err != nil {
+		return nil, maybeExtendAuthzError(err)
+	}
+	aa.withHTTPClient(spt)
+	return autorest.NewBearerAuthorizer(spt), nil
+}
+
+// oidcClientAssertion sends OIDC token as client assertion every time AAD token is refreshed
+type oidcClientAssertion struct {
+	aa *AzureAuth
+}
+
+func (a *oidcClientAssertion) SetAuthenticationValues(spt *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := a.aa.getOIDCToken()
+	if err != nil {
+		return err
+	}
+	v.Set("client_assertion", token)
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+// getOIDCToken returns the OIDC token, that is either given directly, read from
+// a projected service account token file or requested from GitHub Actions
+func (aa *AzureAuth) getOIDCToken() (string, error) {
+	if aa.OIDCToken != "" {
+		return aa.OIDCToken, nil
+	}
+	if aa.OIDCTokenFilePath != "" {
+		// file is rotated by Kubernetes, so it has to be read every time
+		raw, err := ioutil.ReadFile(aa.OIDCTokenFilePath)
+		if err != nil {
+			return "", fmt.Errorf("cannot read OIDC token file: %w", err)
+		}
+		return strings.TrimSpace(string(raw)), nil
+	}
+	if aa.OIDCRequestURL != "" && aa.OIDCRequestToken != "" {
+		return aa.requestGitHubOIDCToken()
+	}
+	return "", fmt.Errorf("OIDC token is not available: set azure_oidc_token, " +
+		"azure_oidc_token_file_path or run within GitHub Actions with `id-token: write` permission")
+}
+
+func (aa *AzureAuth) requestGitHubOIDCToken() (string, error) {
+	requestURL, err := url.Parse(aa.OIDCRequestURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid OIDC request URL: %w", err)
+	}
+	query := requestURL.Query()
+	query.Set("audience", azureOIDCAudience)
+	requestURL.RawQuery = query.Encode()
+	req, err := http.NewRequest(http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", aa.OIDCRequestToken))
+	req.Header.Set("Accept", "application/json")
+	client := http.DefaultClient
+	if aa.databricksClient != nil && aa.databricksClient.httpClient != nil {
+		client = aa.databricksClient.httpClient.HTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("cannot request OIDC token: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot request OIDC token: %s %s", resp.Status, body)
+	}
+	var tokenResponse struct {
+		Value string `json:"value"`
+	}
+	err = json.Unmarshal(body, &tokenResponse)
+	if err != nil {
+		return "", fmt.Errorf("cannot parse OIDC token response: %w", err)
+	}
+	return tokenResponse.Value, nil
+}
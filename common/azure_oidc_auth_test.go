@@ -0,0 +1,120 @@
+package common
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureWithOIDC_NotUsed(t *testing.T) {
+	aa := AzureAuth{}
+	auth, err := aa.configureWithOIDC()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+
+	aa = AzureAuth{
+		UseOIDC:          true,
+		databricksClient: &DatabricksClient{Host: "https://abc.cloud.databricks.com/"},
+	}
+	auth, err = aa.configureWithOIDC()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+}
+
+func TestConfigureWithOIDC_NoClientID(t *testing.T) {
+	aa := AzureAuth{
+		UseOIDC:          true,
+		databricksClient: &DatabricksClient{Host: "https://adb-123.4.azuredatabricks.net/"},
+	}
+	_, err := aa.configureWithOIDC()
+	assert.EqualError(t, err, "azure_client_id and azure_tenant_id are required for OIDC authentication")
+}
+
+func TestConfigureWithOIDC(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://adb-123.4.azuredatabricks.net/",
+		AuthType: "azure-oidc",
+		AzureAuth: AzureAuth{
+			ClientID:  "a",
+			TenantID:  "b",
+			UseOIDC:   true,
+			OIDCToken: "oidc",
+		},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, dc.authVisitor)
+}
+
+func TestGetOIDCToken(t *testing.T) {
+	aa := AzureAuth{}
+	_, err := aa.getOIDCToken()
+	AssertErrorStartsWith(t, err, "OIDC token is not available")
+
+	aa.OIDCToken = "direct"
+	token, err := aa.getOIDCToken()
+	require.NoError(t, err)
+	assert.Equal(t, "direct", token)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	err = ioutil.WriteFile(tokenFile, []byte("from-file\n"), 0600)
+	require.NoError(t, err)
+	aa = AzureAuth{OIDCTokenFilePath: tokenFile}
+	token, err = aa.getOIDCToken()
+	require.NoError(t, err)
+	assert.Equal(t, "from-file", token)
+
+	aa.OIDCTokenFilePath = tokenFile + ".missing"
+	_, err = aa.getOIDCToken()
+	AssertErrorStartsWith(t, err, "cannot read OIDC token file")
+}
+
+func TestGetOIDCToken_GitHubActions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "Bearer request-token", req.Header.Get("Authorization"))
+			assert.Equal(t, "api://AzureADTokenExchange", req.URL.Query().Get("audience"))
+			assert.Equal(t, "1", req.URL.Query().Get("api-version"))
+			_, err := rw.Write([]byte(`{"value": "from-github"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+
+	aa := AzureAuth{
+		OIDCRequestURL:   server.URL + "/token?api-version=1",
+		OIDCRequestToken: "request-token",
+	}
+	token, err := aa.getOIDCToken()
+	require.NoError(t, err)
+	assert.Equal(t, "from-github", token)
+
+	assertion := oidcClientAssertion{&aa}
+	values := url.Values{}
+	err = assertion.SetAuthenticationValues(nil, &values)
+	require.NoError(t, err)
+	assert.Equal(t, "from-github", values.Get("client_assertion"))
+	assert.Equal(t, "urn:ietf:params:oauth:client-assertion-type:jwt-bearer",
+		values.Get("client_assertion_type"))
+}
+
+func TestGetOIDCToken_GitHubActionsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			rw.WriteHeader(403)
+			_, err := rw.Write([]byte(`nope`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+
+	aa := AzureAuth{
+		OIDCRequestURL:   server.URL,
+		OIDCRequestToken: "request-token",
+	}
+	_, err := aa.getOIDCToken()
+	assert.EqualError(t, err, "cannot request OIDC token: 403 Forbidden nope")
+}
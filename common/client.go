@@ -3,10 +3,13 @@ package common
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -22,9 +25,14 @@ import (
 
 // Default settings
 const (
-	DefaultTruncateBytes      = 96
-	DefaultRateLimitPerSecond = 15
-	DefaultHTTPTimeoutSeconds = 60
+	DefaultTruncateBytes       = 96
+	DefaultRateLimitPerSecond  = 15
+	DefaultHTTPTimeoutSeconds  = 60
+	DefaultRetryTimeoutSeconds = 300
+	DefaultMaxRetries          = 30
+	// all requests of the provider go to one or two hosts, so keeping more idle
+	// connections than http.DefaultMaxIdleConnsPerHost avoids TLS handshakes
+	DefaultMaxIdleConnsPerHost = 20
 )
 
 // DatabricksClient is the client struct that contains clients for all the services available on Databricks
@@ -36,24 +44,45 @@ type DatabricksClient struct {
 	Profile            string
 	ConfigFile         string
 	AccountID          string
+	AuthType           string
 	AzureAuth          AzureAuth
 	InsecureSkipVerify bool
+	// PEM file with certificates of private CAs, that are trusted in addition to system ones
+	TLSCAFile          string
+	HTTPProxy          string
 	DevelopmentMode    bool
 	HTTPTimeoutSeconds int
 	DebugTruncateBytes int
 	DebugHeaders       bool
+	// product/version of the tool built on top of the provider, appended to User-Agent
+	Partner string
+	// tags from provider configuration, that are added to custom_tags of clusters, pools and jobs
+	DefaultTags        map[string]string
 	RateLimitPerSecond int
-	authMutex          sync.Mutex
-	rateLimiter        *rate.Limiter
-	Provider           *schema.Provider
-	httpClient         *retryablehttp.Client
-	authVisitor        func(r *http.Request) error
-	commandFactory     func(context.Context, *DatabricksClient) CommandExecutor
+	// total time budget for retrying transient errors of a single API call.
+	// Defaults are used when nil, zero disables retries.
+	RetryTimeoutSeconds *int
+	MaxRetries          *int
+	MaxIdleConnsPerHost int
+	// fixed wait between polls of long-running operations, exponential backoff if zero
+	PollIntervalSeconds int
+	// file, where JSON lines about every mutating API call are appended
+	AuditLogPath   string
+	auditMutex     sync.Mutex
+	authMutex      sync.Mutex
+	rateLimiter    *rate.Limiter
+	Provider       *schema.Provider
+	httpClient     *retryablehttp.Client
+	authVisitor    func(r *http.Request) error
+	commandFactory func(context.Context, *DatabricksClient) CommandExecutor
 }
 
 // Configure client to work
 func (c *DatabricksClient) Configure() error {
-	c.configureHTTPCLient()
+	err := c.configureHTTPCLient()
+	if err != nil {
+		return err
+	}
 	c.AzureAuth.databricksClient = c
 	if c.DebugTruncateBytes == 0 {
 		c.DebugTruncateBytes = DefaultTruncateBytes
@@ -74,9 +103,20 @@ func (c *DatabricksClient) Authenticate() error {
 	authorizers := []func() (func(r *http.Request) error, error){
 		c.configureAuthWithDirectParams,
 		c.AzureAuth.configureWithClientSecret,
+		c.AzureAuth.configureWithClientCertificate,
+		c.AzureAuth.configureWithMSI,
+		c.AzureAuth.configureWithOIDC,
 		c.AzureAuth.configureWithAzureCLI,
+		c.configureWithGoogleDefaultCredentials,
 		c.configureFromDatabricksCfg,
 	}
+	if c.AuthType != "" {
+		forced, err := c.forcedAuthorizer()
+		if err != nil {
+			return err
+		}
+		authorizers = []func() (func(r *http.Request) error, error){forced}
+	}
 	for _, authProvider := range authorizers {
 		authorizer, err := authProvider()
 		if err != nil {
@@ -96,10 +136,45 @@ func (c *DatabricksClient) Authenticate() error {
 		"3. azure_databricks_workspace_id + AZ CLI authentication.\n" +
 		"4. azure_databricks_workspace_id + azure_client_id + azure_client_secret + azure_tenant_id " +
 		"for Azure Service Principal authentication.\n" +
-		"5. Run `databricks configure --token` that will create ~/.databrickscfg file.\n\n" +
+		"5. azure_databricks_workspace_id + azure_client_id + azure_client_certificate_path + azure_tenant_id " +
+		"for Azure Service Principal certificate authentication.\n" +
+		"6. azure_databricks_workspace_id + azure_use_msi for Azure Managed Service Identity authentication.\n" +
+		"7. azure_databricks_workspace_id + azure_client_id + azure_tenant_id + azure_use_oidc " +
+		"for Azure federated credentials of GitHub Actions or Kubernetes.\n" +
+		"8. host pointing to Databricks on GCP workspace + Google Application Default Credentials.\n" +
+		"9. Run `databricks configure --token` that will create ~/.databrickscfg file.\n\n" +
 		"Please check https://registry.terraform.io/providers/databrickslabs/databricks/latest/docs#authentication for details")
 }
 
+// forcedAuthorizer returns the only authorizer, that is explicitly selected by auth_type
+func (c *DatabricksClient) forcedAuthorizer() (func() (func(r *http.Request) error, error), error) {
+	authorizers := map[string]func() (func(r *http.Request) error, error){
+		"pat":                      c.configureWithToken,
+		"basic":                    c.configureWithBasicAuth,
+		"azure-client-secret":      c.AzureAuth.configureWithClientSecret,
+		"azure-client-certificate": c.AzureAuth.configureWithClientCertificate,
+		"azure-msi":                c.AzureAuth.configureWithMSI,
+		"azure-oidc":               c.AzureAuth.configureWithOIDC,
+		"azure-cli":                c.AzureAuth.configureWithAzureCLI,
+		"google-id":                c.configureWithGoogleDefaultCredentials,
+		"databricks-cli":           c.configureFromDatabricksCfg,
+	}
+	authorizer, ok := authorizers[c.AuthType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported auth_type: %s", c.AuthType)
+	}
+	return func() (func(r *http.Request) error, error) {
+		visitor, err := authorizer()
+		if err != nil {
+			return nil, err
+		}
+		if visitor == nil {
+			return nil, fmt.Errorf("auth_type=%s is not applicable for the current configuration", c.AuthType)
+		}
+		return visitor, nil
+	}, nil
+}
+
 func (c *DatabricksClient) fixHost() {
 	if c.Host != "" && !(strings.HasPrefix(c.Host, "https://") || strings.HasPrefix(c.Host, "http://")) {
 		// azurerm_databricks_workspace.*.workspace_url is giving URL without scheme
@@ -130,6 +205,30 @@ func (c *DatabricksClient) configureAuthWithDirectParams() (func(r *http.Request
 	return c.authorizer(authType, c.Token), nil
 }
 
+// configureWithToken uses only host and token, ignoring username and password
+func (c *DatabricksClient) configureWithToken() (func(r *http.Request) error, error) {
+	if c.Token == "" {
+		return nil, nil
+	}
+	if c.Host == "" {
+		return nil, fmt.Errorf("host is empty, but is required by token")
+	}
+	log.Printf("[INFO] Using directly configured host+token authentication")
+	return c.authorizer("Bearer", c.Token), nil
+}
+
+// configureWithBasicAuth uses only host, username and password, ignoring token
+func (c *DatabricksClient) configureWithBasicAuth() (func(r *http.Request) error, error) {
+	if c.Username == "" || c.Password == "" {
+		return nil, nil
+	}
+	if c.Host == "" {
+		return nil, fmt.Errorf("host is empty, but is required by basic_auth")
+	}
+	log.Printf("[INFO] Using basic auth for user '%s'", c.Username)
+	return c.authorizer("Basic", c.encodeBasicAuth(c.Username, c.Password)), nil
+}
+
 func (c *DatabricksClient) configureFromDatabricksCfg() (func(r *http.Request) error, error) {
 	configFile := c.ConfigFile
 	if configFile == "" {
@@ -159,6 +258,12 @@ func (c *DatabricksClient) configureFromDatabricksCfg() (func(r *http.Request) e
 		return nil, fmt.Errorf("%s has no %s profile configured", configFile, c.Profile)
 	}
 	c.Host = dbcli.Key("host").String()
+	if c.AccountID == "" {
+		c.AccountID = dbcli.Key("account_id").String()
+	}
+	if profileAuth, err := c.configureFromProfileAuthType(dbcli); err != nil || profileAuth != nil {
+		return profileAuth, err
+	}
 	if c.Host == "" {
 		return nil, fmt.Errorf("config file %s is corrupt: cannot find host in %s profile",
 			configFile, c.Profile)
@@ -180,6 +285,36 @@ func (c *DatabricksClient) configureFromDatabricksCfg() (func(r *http.Request) e
 	return c.authorizer(authType, c.Token), nil
 }
 
+// configureFromProfileAuthType handles profiles, that describe authentication
+// other than token or username/password, like Azure service principal or Azure CLI
+func (c *DatabricksClient) configureFromProfileAuthType(dbcli *ini.Section) (func(r *http.Request) error, error) {
+	aa := &c.AzureAuth
+	if aa.ResourceID == "" {
+		aa.ResourceID = dbcli.Key("azure_workspace_resource_id").String()
+	}
+	if !aa.IsClientSecretSet() && dbcli.HasKey("azure_client_id") {
+		aa.ClientID = dbcli.Key("azure_client_id").String()
+		aa.ClientSecret = dbcli.Key("azure_client_secret").String()
+		aa.TenantID = dbcli.Key("azure_tenant_id").String()
+	}
+	profileAuthType := dbcli.Key("auth_type").String()
+	if profileAuthType == "" && aa.IsClientSecretSet() {
+		profileAuthType = "azure-client-secret"
+	}
+	switch profileAuthType {
+	case "", "pat", "basic", "databricks-cli":
+		// token or username and password from the same profile
+		return nil, nil
+	}
+	log.Printf("[INFO] Using auth_type=%s from %s profile", profileAuthType, c.Profile)
+	c.AuthType = profileAuthType
+	forced, err := c.forcedAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+	return forced()
+}
+
 func (c *DatabricksClient) authorizer(authType, token string) func(r *http.Request) error {
 	return func(r *http.Request) error {
 		r.Header.Set("Authorization", fmt.Sprintf("%s %s", authType, token))
@@ -192,7 +327,7 @@ func (c *DatabricksClient) encodeBasicAuth(username, password string) string {
 	return base64.StdEncoding.EncodeToString([]byte(tokenUnB64))
 }
 
-func (c *DatabricksClient) configureHTTPCLient() {
+func (c *DatabricksClient) configureHTTPCLient() error {
 	if c.HTTPTimeoutSeconds == 0 {
 		c.HTTPTimeoutSeconds = DefaultHTTPTimeoutSeconds
 	}
@@ -200,42 +335,118 @@ func (c *DatabricksClient) configureHTTPCLient() {
 		c.RateLimitPerSecond = DefaultRateLimitPerSecond
 	}
 	c.rateLimiter = rate.NewLimiter(rate.Limit(c.RateLimitPerSecond), 1)
+	if c.MaxIdleConnsPerHost == 0 {
+		c.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	}
 	// Set up a retryable HTTP Client to handle cases where the service returns
 	// a transient error on initial creation
-	retryDelayDuration := 10 * time.Second
-	retryMaximumDuration := 5 * time.Minute
 	defaultTransport := http.DefaultTransport.(*http.Transport)
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honored,
+	// unless proxy is explicitly configured for the provider
+	proxy := defaultTransport.Proxy
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return fmt.Errorf("invalid http_proxy: %w", err)
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return err
+	}
 	c.httpClient = &retryablehttp.Client{
 		HTTPClient: &http.Client{
 			Timeout: time.Duration(c.HTTPTimeoutSeconds) * time.Second,
 			Transport: &http.Transport{
-				Proxy:                 defaultTransport.Proxy,
+				Proxy:                 proxy,
 				DialContext:           defaultTransport.DialContext,
 				MaxIdleConns:          defaultTransport.MaxIdleConns,
+				MaxIdleConnsPerHost:   c.MaxIdleConnsPerHost,
 				IdleConnTimeout:       defaultTransport.IdleConnTimeout * 3,
 				TLSHandshakeTimeout:   defaultTransport.TLSHandshakeTimeout * 3,
 				ExpectContinueTimeout: defaultTransport.ExpectContinueTimeout,
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: c.InsecureSkipVerify,
-				},
+				TLSClientConfig:       tlsConfig,
 			},
 		},
 		CheckRetry: c.checkHTTPRetry,
-		// Using a linear retry rather than the default exponential retry
-		// as the creation condition is normally passed after 30-40 seconds
-		// Setting the retry interval to 10 seconds. Setting RetryWaitMin and RetryWaitMax
-		// to the same value removes jitter (which would be useful in a high-volume traffic scenario
-		// but wouldn't add much here)
-		Backoff:      retryablehttp.LinearJitterBackoff,
-		RetryWaitMin: retryDelayDuration,
-		RetryWaitMax: retryDelayDuration,
-		RetryMax:     int(retryMaximumDuration / retryDelayDuration),
+		// retries have to be throttled by the same token bucket as initial requests
+		RequestLogHook: c.throttleRetries,
+		// Exponential backoff starts with one second and doesn't wait longer than 10 seconds
+		// between attempts, because workspace creation conditions are normally passed
+		// after 30-40 seconds. The whole retry loop is bounded by RetryTimeoutSeconds.
+		Backoff:      retryAfterBackoff,
+		RetryWaitMin: 1 * time.Second,
+		RetryWaitMax: 10 * time.Second,
+		RetryMax:     c.maxRetries(),
 	}
+	return nil
+}
+
+// maxRetries returns the number of retries of transient errors, where zero disables them
+func (c *DatabricksClient) maxRetries() int {
+	if c.MaxRetries == nil {
+		return DefaultMaxRetries
+	}
+	return *c.MaxRetries
+}
+
+// retryTimeout returns the time budget for retries of transient errors, where zero disables them
+func (c *DatabricksClient) retryTimeout() time.Duration {
+	if c.RetryTimeoutSeconds == nil {
+		return DefaultRetryTimeoutSeconds * time.Second
+	}
+	return time.Duration(*c.RetryTimeoutSeconds) * time.Second
+}
+
+func (c *DatabricksClient) throttleRetries(_ retryablehttp.Logger, r *http.Request, attempt int) {
+	if attempt == 0 {
+		// initial request already waited in genericQuery
+		return
+	}
+	if err := c.rateLimiter.Wait(r.Context()); err != nil {
+		log.Printf("[WARN] Cannot throttle retry: %v", err)
+	}
+}
+
+func (c *DatabricksClient) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	if c.TLSCAFile == "" {
+		return config, nil
+	}
+	caFile, err := homedir.Expand(c.TLSCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read tls_ca_file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		log.Printf("[WARN] Cannot load system certificates: %v", err)
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("tls_ca_file %s has no PEM certificates", c.TLSCAFile)
+	}
+	config.RootCAs = pool
+	return config, nil
 }
 
 // IsAzure returns true if client is configured for Azure Databricks - either by using AAD auth or with host+token combination
 func (c *DatabricksClient) IsAzure() bool {
-	return c.AzureAuth.resourceID() != "" || strings.Contains(c.Host, ".azuredatabricks.net")
+	if c.AzureAuth.resourceID() != "" {
+		return true
+	}
+	for _, suffix := range azureDatabricksHostSuffixes {
+		if strings.Contains(c.Host, suffix) {
+			return true
+		}
+	}
+	return false
 }
 
 // IsAws returns true if client is configured for AWS
@@ -1,11 +1,19 @@
 package common
 
 import (
+	"context"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func AssertErrorStartsWith(t *testing.T, err error, message string) bool {
@@ -98,6 +106,49 @@ func TestDatabricksClientConfigure_ConfigRead(t *testing.T) {
 	assert.Equal(t, "PT0+IC9kZXYvdXJhbmRvbSA8PT0KYFZ", dc.Token)
 }
 
+func TestDatabricksClientConfigure_ConfigReadAccountID(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		ConfigFile: "testdata/.databrickscfg",
+		Profile:    "account",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "PT0+IC9kZXYvdXJhbmRvbSA8PT0KYFZ", dc.Token)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", dc.AccountID)
+}
+
+func TestDatabricksClientConfigure_ConfigReadAzureServicePrincipal(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		ConfigFile: "testdata/.databrickscfg",
+		Profile:    "azure-sp",
+	})
+	require.NoError(t, err)
+	assert.True(t, dc.IsAzure())
+	assert.Equal(t, "azure-client-secret", dc.AuthType)
+	assert.Equal(t, "a", dc.AzureAuth.ClientID)
+	assert.Equal(t, "b", dc.AzureAuth.ClientSecret)
+	assert.Equal(t, "c", dc.AzureAuth.TenantID)
+	assert.Equal(t, "", dc.Token)
+}
+
+func TestDatabricksClientConfigure_ConfigReadAzureCli(t *testing.T) {
+	defer CleanupEnvironment()()
+	testdata, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+	os.Setenv("PATH", testdata+":/bin")
+
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		ConfigFile: "testdata/.databrickscfg",
+		Profile:    "azure-cli",
+	})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer ...", r.Header.Get("Authorization"))
+	assert.Equal(t, dc.AzureAuth.ResourceID, r.Header.Get("X-Databricks-Azure-Workspace-Resource-Id"))
+}
+
 func TestDatabricksClientConfigure_NoHostGivesError(t *testing.T) {
 	_, err := configureAndAuthenticate(&DatabricksClient{
 		Token:      "connfigured",
@@ -156,3 +207,205 @@ func TestDatabricksClient_FormatURL(t *testing.T) {
 	client := DatabricksClient{Host: "https://some.host"}
 	assert.Equal(t, "https://some.host/#job/123", client.FormatURL("#job/123"))
 }
+
+func TestDatabricksClientConfigure_UnsupportedAuthType(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://localhost:443",
+		Token:    "...",
+		AuthType: "magic",
+	})
+	AssertErrorStartsWith(t, err, "unsupported auth_type: magic")
+}
+
+func TestDatabricksClientConfigure_AzureCliAuthTypeNotApplicable(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://localhost:443",
+		Token:    "...",
+		AuthType: "azure-cli",
+	})
+	AssertErrorStartsWith(t, err, "auth_type=azure-cli is not applicable for the current configuration")
+}
+
+func TestDatabricksClientConfigure_AzureCliAuthTypeOverSP(t *testing.T) {
+	defer CleanupEnvironment()()
+	testdata, err := filepath.Abs("testdata")
+	assert.NoError(t, err)
+	os.Setenv("PATH", testdata+":/bin")
+
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://adb-123.4.azuredatabricks.net/",
+		AuthType: "azure-cli",
+		AzureAuth: AzureAuth{
+			ResourceID:   "/subscriptions/a/resourceGroups/b/providers/Microsoft.Databricks/workspaces/c",
+			ClientID:     "a",
+			ClientSecret: "b",
+			TenantID:     "c",
+		},
+	})
+	assert.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer ...", r.Header.Get("Authorization"))
+	assert.Equal(t, "...", r.Header.Get("X-Databricks-Azure-SP-Management-Token"))
+}
+
+func TestDatabricksClientConfigure_PatAuthTypeOverBasic(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://localhost:443",
+		Token:    "...",
+		Username: "a",
+		Password: "b",
+		AuthType: "pat",
+	})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer ...", r.Header.Get("Authorization"))
+}
+
+func TestDatabricksClientConfigure_BasicAuthTypeOverPat(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://localhost:443",
+		Token:    "...",
+		Username: "a",
+		Password: "b",
+		AuthType: "basic",
+	})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Basic YTpi", r.Header.Get("Authorization"))
+}
+
+func TestDatabricksClientConfigure_DatabricksCliAuthTypeOverPat(t *testing.T) {
+	defer CleanupEnvironment()()
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:       "https://localhost:443",
+		Token:      "...",
+		ConfigFile: "testdata/.databrickscfg",
+		AuthType:   "databricks-cli",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://dbc-XXXXXXXX-YYYY.cloud.databricks.com/", dc.Host)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer PT0+IC9kZXYvdXJhbmRvbSA8PT0KYFZ", r.Header.Get("Authorization"))
+}
+
+func TestDatabricksClientConfigure_AzureClientSecretAuthTypeNotApplicable(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://adb-123.4.azuredatabricks.net/",
+		Token:    "...",
+		AuthType: "azure-client-secret",
+	})
+	AssertErrorStartsWith(t, err, "auth_type=azure-client-secret is not applicable for the current configuration")
+}
+
+func TestDatabricksClientConfigure_InvalidHTTPProxy(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:      "https://localhost:443",
+		Token:     "...",
+		HTTPProxy: "ht_tp://proxy",
+	})
+	AssertErrorStartsWith(t, err, "invalid http_proxy")
+}
+
+func TestDatabricksClientConfigure_TLSCAFile(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	err := ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0600)
+	require.NoError(t, err)
+
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:      server.URL,
+		Token:     "...",
+		TLSCAFile: caFile,
+	})
+	require.NoError(t, err)
+	var resp map[string]string
+	err = dc.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "b", resp["a"])
+}
+
+func TestDatabricksClientConfigure_TLSCAFileErrors(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:      "https://localhost:443",
+		Token:     "...",
+		TLSCAFile: "testdata/nonexistent.pem",
+	})
+	AssertErrorStartsWith(t, err, "cannot read tls_ca_file")
+
+	_, err = configureAndAuthenticate(&DatabricksClient{
+		Host:      "https://localhost:443",
+		Token:     "...",
+		TLSCAFile: "testdata/.databrickscfg",
+	})
+	AssertErrorStartsWith(t, err, "tls_ca_file testdata/.databrickscfg has no PEM certificates")
+}
+
+func TestDatabricksClientConfigure_HTTPTimeout(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:  "https://localhost:443",
+		Token: "...",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultHTTPTimeoutSeconds*time.Second, dc.httpClient.HTTPClient.Timeout)
+
+	dc, err = configureAndAuthenticate(&DatabricksClient{
+		Host:               "https://localhost:443",
+		Token:              "...",
+		HTTPTimeoutSeconds: 600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 600*time.Second, dc.httpClient.HTTPClient.Timeout)
+}
+
+func TestDatabricksClient_IsAzureNationalClouds(t *testing.T) {
+	for _, host := range []string{
+		"https://adb-123.4.azuredatabricks.net/",
+		"https://adb-123.4.databricks.azure.us/",
+		"https://adb-123.4.databricks.azure.cn/",
+	} {
+		dc := DatabricksClient{Host: host}
+		assert.True(t, dc.IsAzure(), host)
+		assert.False(t, dc.IsAws(), host)
+	}
+	dc := DatabricksClient{Host: "https://abc.cloud.databricks.com/"}
+	assert.False(t, dc.IsAzure())
+}
+
+func TestDatabricksClientConfigure_KeepAlive(t *testing.T) {
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:  "https://localhost:443",
+		Token: "...",
+	})
+	require.NoError(t, err)
+	transport := dc.httpClient.HTTPClient.Transport.(*http.Transport)
+	assert.Equal(t, DefaultMaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+
+	dc, err = configureAndAuthenticate(&DatabricksClient{
+		Host:                "https://localhost:443",
+		Token:               "...",
+		MaxIdleConnsPerHost: 100,
+	})
+	require.NoError(t, err)
+	transport = dc.httpClient.HTTPClient.Transport.(*http.Transport)
+	assert.Equal(t, 100, transport.MaxIdleConnsPerHost)
+}
@@ -53,7 +53,7 @@ func (c commandExecutorMock) Execute(clusterID, language, commandStr string) Com
 	return c.mock(commandStr)
 }
 
-// CommandExecutor creates a spark context and executes a command and then closes context
+// CommandExecutor executes a command in a spark context, that is reused between commands
 type CommandExecutor interface {
 	Execute(clusterID, language, commandStr string) CommandResults
 }
@@ -40,6 +40,7 @@ func addContextToStage(name string,
 	ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		ctx = context.WithValue(ctx, ResourceName, name)
+		ctx = context.WithValue(ctx, ResourceID, d.Id())
 		return f(ctx, d, m)
 	}
 }
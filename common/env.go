@@ -46,6 +46,7 @@ func NewClientFromEnvironment() *DatabricksClient {
 		RateLimitPerSecond: 10,
 		DebugTruncateBytes: debugBytes,
 		DebugHeaders:       debugHeaders,
+		Partner:            os.Getenv("DATABRICKS_PARTNER"),
 	}
 	err = client.Configure()
 	if err != nil {
@@ -92,6 +93,8 @@ func CleanupEnvironment() func() {
 	homedir.DisableCache = true
 	// and return restore function
 	return func() {
+		// variables set by the test itself must not leak into other tests
+		os.Clearenv()
 		for _, kv := range prevEnv {
 			kvs := strings.SplitN(kv, "=", 2)
 			os.Setenv(kvs[0], kvs[1])
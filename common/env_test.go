@@ -26,3 +26,9 @@ func TestCommonEnvironmentClient(t *testing.T) {
 	}
 	assert.Equal(t, "databricks-tf-provider/"+version+" (+cluster) terraform/0.12", c.userAgent(ctx))
 }
+
+func TestUserAgentWithPartner(t *testing.T) {
+	c := DatabricksClient{Partner: "my-tool/0.1.2"}
+	assert.Equal(t, "databricks-tf-provider/"+version+" (+unknown) terraform/unknown my-tool/0.1.2",
+		c.userAgent(context.Background()))
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/idtoken"
+	"google.golang.org/api/option"
+)
+
+const gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// configureWithGoogleDefaultCredentials picks up Google Application Default Credentials
+// (GOOGLE_APPLICATION_CREDENTIALS, gcloud well-known file or GCE metadata server) for
+// Databricks on GCP workspaces, when no token is explicitly configured or auth_type is google-id.
+func (c *DatabricksClient) configureWithGoogleDefaultCredentials() (func(r *http.Request) error, error) {
+	if !c.IsGcp() || (c.Token != "" && c.AuthType != "google-id") {
+		return nil, nil
+	}
+	c.fixHost()
+	ctx := context.Background()
+	creds, err := google.FindDefaultCredentials(ctx, gcpCloudPlatformScope)
+	if err != nil {
+		log.Printf("[INFO] Google Application Default Credentials are not available: %v", err)
+		return nil, nil
+	}
+	opts := []option.ClientOption{}
+	if len(creds.JSON) > 0 {
+		opts = append(opts, option.WithCredentialsJSON(creds.JSON))
+	}
+	// Databricks on GCP accepts only ID tokens of service accounts, so end-user
+	// credentials from `gcloud auth application-default login` are not enough.
+	idTokenSource, err := idtoken.NewTokenSource(ctx, c.Host, opts...)
+	if err != nil {
+		log.Printf("[WARN] Cannot use Google Application Default Credentials: %v", err)
+		return nil, nil
+	}
+	log.Printf("[INFO] Using Google Application Default Credentials")
+	return c.googleTokenVisitor(idTokenSource, creds.TokenSource), nil
+}
+
+func (c *DatabricksClient) googleTokenVisitor(idTokenSource, accessTokenSource oauth2.TokenSource) func(r *http.Request) error {
+	return func(r *http.Request) error {
+		idToken, err := idTokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("cannot get Google ID token: %w", err)
+		}
+		accessToken, err := accessTokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("cannot get Google access token: %w", err)
+		}
+		r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", idToken.AccessToken))
+		r.Header.Set("X-Databricks-GCP-SA-Access-Token", accessToken.AccessToken)
+		return nil
+	}
+}
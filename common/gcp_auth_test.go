@@ -0,0 +1,114 @@
+package common
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureWithGoogleDefaultCredentials_NotGcp(t *testing.T) {
+	dc := DatabricksClient{
+		Host: "https://abc.cloud.databricks.com",
+	}
+	auth, err := dc.configureWithGoogleDefaultCredentials()
+	assert.NoError(t, err)
+	assert.Nil(t, auth)
+}
+
+// googleServiceAccountFixture points GOOGLE_APPLICATION_CREDENTIALS to a service account,
+// that gets tokens from a local server, and returns the ID token it issues
+func googleServiceAccountFixture(t *testing.T) string {
+	// ID token is parsed for its expiry, so it has to look like a JWT
+	idToken := fmt.Sprintf("e30.%s.c2ln", base64.RawURLEncoding.EncodeToString(
+		[]byte(fmt.Sprintf(`{"exp": %d}`, time.Now().Add(time.Hour).Unix()))))
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			require.NoError(t, req.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", req.Form.Get("grant_type"))
+			_, err := rw.Write([]byte(fmt.Sprintf(`{
+				"access_token": "access",
+				"id_token": "%s",
+				"token_type": "Bearer",
+				"expires_in": 3600
+			}`, idToken)))
+			assert.NoError(t, err)
+		}))
+	t.Cleanup(server.Close)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	serviceAccount, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "a",
+		"private_key_id": "b",
+		"private_key": string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})),
+		"client_email": "terraform@a.iam.gserviceaccount.com",
+		"client_id":    "c",
+		"token_uri":    server.URL,
+	})
+	require.NoError(t, err)
+	credentials := filepath.Join(t.TempDir(), "credentials.json")
+	err = ioutil.WriteFile(credentials, serviceAccount, 0600)
+	require.NoError(t, err)
+	os.Setenv("GOOGLE_APPLICATION_CREDENTIALS", credentials)
+	return idToken
+}
+
+func TestConfigureWithGoogleDefaultCredentials(t *testing.T) {
+	defer CleanupEnvironment()()
+	idToken := googleServiceAccountFixture(t)
+
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host: "123.4.gcp.databricks.com",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://123.4.gcp.databricks.com", dc.Host)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer "+idToken, r.Header.Get("Authorization"))
+	assert.Equal(t, "access", r.Header.Get("X-Databricks-GCP-SA-Access-Token"))
+}
+
+func TestConfigureWithGoogleDefaultCredentials_AuthTypeOverToken(t *testing.T) {
+	defer CleanupEnvironment()()
+	idToken := googleServiceAccountFixture(t)
+
+	dc, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://123.4.gcp.databricks.com",
+		Token:    "...",
+		AuthType: "google-id",
+	})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	err = dc.authVisitor(r)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer "+idToken, r.Header.Get("Authorization"))
+}
+
+func TestConfigureWithGoogleDefaultCredentials_AuthTypeNotApplicable(t *testing.T) {
+	_, err := configureAndAuthenticate(&DatabricksClient{
+		Host:     "https://abc.cloud.databricks.com",
+		AuthType: "google-id",
+	})
+	AssertErrorStartsWith(t, err, "auth_type=google-id is not applicable for the current configuration")
+}
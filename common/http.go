@@ -8,16 +8,26 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/go-retryablehttp"
 )
 
+// PartnerRegex matches `product/version` segments, that are appended to User-Agent
+var PartnerRegex = regexp.MustCompile(`^[a-zA-Z0-9_.+-]+/[a-zA-Z0-9_.+-]+$`)
+
+// retryStartedAt is the context key for the time of the first attempt of an API call
+var retryStartedAt contextKey = 4
+
 var (
 	e2example                   = "https://registry.terraform.io/providers/databrickslabs/databricks/latest/docs/guides/aws-workspace"
 	accountsHost                = "accounts.cloud.databricks.com"
@@ -72,7 +82,7 @@ func (apiError APIError) IsTooManyRequests() bool {
 
 // DocumentationURL guesses doc link
 func (apiError APIError) DocumentationURL() string {
-	endpointRE := regexp.MustCompile(`/api/2.0/([^/]+)/([^/]+)$`)
+	endpointRE := regexp.MustCompile(`/api/2\.[01]/([^/]+)/([^/]+)$`)
 	endpointMatches := endpointRE.FindStringSubmatch(apiError.Resource)
 	if len(endpointMatches) < 3 {
 		return ""
@@ -90,7 +100,14 @@ func (apiError APIError) IsRetriable() bool {
 			return true
 		}
 	}
-	// some API's recommend retries on HTTP 500, but we'll add that later
+	if apiError.StatusCode == http.StatusServiceUnavailable {
+		log.Printf("[INFO] Attempting retry because of HTTP %d", apiError.StatusCode)
+		return true
+	}
+	if apiError.ErrorCode == "TEMPORARILY_UNAVAILABLE" {
+		log.Printf("[INFO] Attempting retry because of %s", apiError.ErrorCode)
+		return true
+	}
 	return false
 }
 
@@ -148,7 +165,7 @@ func (c *DatabricksClient) commonErrorClarity(resp *http.Response) *APIError {
 		}
 	}
 	// common confusion with this provider: calling workspace apis on accounts host
-	if !isTesting && isAccountsAPI && !isAccountsClient {
+	if !isTesting && isAccountsAPI && !isAccountsClient && c.AccountID == "" {
 		return &APIError{
 			ErrorCode: "INCORRECT_CONFIGURATION",
 			Message: fmt.Sprintf("Accounts API (%s) requires you to set %s as DATABRICKS_HOST, but you have "+
@@ -207,6 +224,25 @@ func (c *DatabricksClient) parseError(resp *http.Response) APIError {
 
 // checkHTTPRetry inspects HTTP errors from the Databricks API for known transient errors on Workspace creation
 func (c *DatabricksClient) checkHTTPRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		// request was cancelled, e.g. by Ctrl-C in Terraform
+		return false, ctx.Err()
+	}
+	retry, err := c.checkTransientError(resp, err)
+	if !retry {
+		return false, err
+	}
+	if started, ok := ctx.Value(retryStartedAt).(time.Time); ok {
+		timeout := c.retryTimeout()
+		if time.Since(started) > timeout {
+			log.Printf("[WARN] Not retrying, as %s of retry timeout has passed", timeout)
+			return false, err
+		}
+	}
+	return true, err
+}
+
+func (c *DatabricksClient) checkTransientError(resp *http.Response, err error) (bool, error) {
 	if ue, ok := err.(*url.Error); ok {
 		apiError := APIError{ErrorCode: "IO_ERROR", Message: ue.Error()}
 		return apiError.IsRetriable(), apiError
@@ -230,6 +266,46 @@ func (c *DatabricksClient) checkHTTPRetry(ctx context.Context, resp *http.Respon
 	return false, nil
 }
 
+// maxRetryAfter caps the wait requested by Retry-After header of rate-limited responses
+const maxRetryAfter = 60 * time.Second
+
+// retryAfterBackoff waits for as long as HTTP 429 or 503 response asks in Retry-After header,
+// which could be either number of seconds or HTTP date, but no longer than maxRetryAfter.
+// Otherwise it falls back to exponential backoff.
+func retryAfterBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > maxRetryAfter {
+				wait = maxRetryAfter
+			}
+			log.Printf("[INFO] Waiting %s before retrying, as requested by server", wait)
+			return wait
+		}
+	}
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, nil)
+}
+
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
 // Get on path
 func (c *DatabricksClient) Get(ctx context.Context, path string, request interface{}, response interface{}) error {
 	body, err := c.authenticatedQuery(ctx, http.MethodGet, path, request, c.api2)
@@ -248,6 +324,43 @@ func (c *DatabricksClient) Post(ctx context.Context, path string, request interf
 	return c.unmarshall(path, body, &response)
 }
 
+// PostMultipart sends form fields and file content on path as multipart/form-data, so that
+// large files are uploaded with a single request without base64 encoding
+func (c *DatabricksClient) PostMultipart(ctx context.Context, path string, fields map[string]string,
+	fileField string, content []byte, response interface{}) error {
+	var request bytes.Buffer
+	form := multipart.NewWriter(&request)
+	keys := []string{}
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := form.WriteField(k, fields[k]); err != nil {
+			return err
+		}
+	}
+	file, err := form.CreateFormFile(fileField, fileField)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(content); err != nil {
+		return err
+	}
+	if err = form.Close(); err != nil {
+		return err
+	}
+	body, err := c.authenticatedQuery(ctx, http.MethodPost, path, request.Bytes(), c.api2,
+		func(r *http.Request) error {
+			r.Header.Set("Content-Type", form.FormDataContentType())
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+	return c.unmarshall(path, body, &response)
+}
+
 // Delete on path
 func (c *DatabricksClient) Delete(ctx context.Context, path string, request interface{}) error {
 	_, err := c.authenticatedQuery(ctx, http.MethodDelete, path, request, c.api2)
@@ -290,10 +403,18 @@ func (c *DatabricksClient) api2(r *http.Request) error {
 	if r.URL == nil {
 		return fmt.Errorf("no URL found in request")
 	}
-	r.URL.Path = fmt.Sprintf("/api/2.0%s", r.URL.Path)
+	apiVersion := API_2_0
+	if v, ok := r.Context().Value(Api).(string); ok && v != "" {
+		apiVersion = v
+	}
+	r.URL.Path = fmt.Sprintf("/api/%s%s", apiVersion, r.URL.Path)
 	r.Header.Set("Content-Type", "application/json")
 
-	url, err := url.Parse(c.Host)
+	host := c.Host
+	if c.isAccountsAPI(r.URL.Path) {
+		host = c.accountsURL()
+	}
+	url, err := url.Parse(host)
 	if err != nil {
 		return err
 	}
@@ -303,6 +424,19 @@ func (c *DatabricksClient) api2(r *http.Request) error {
 	return nil
 }
 
+// isAccountsAPI returns true, if request has to be sent to accounts host
+// instead of workspace host, because provider has account_id configured
+func (c *DatabricksClient) isAccountsAPI(path string) bool {
+	return c.AccountID != "" && strings.HasPrefix(path, "/api/2.0/accounts/")
+}
+
+func (c *DatabricksClient) accountsURL() string {
+	if strings.Contains(c.Host, accountsHost) {
+		return c.Host
+	}
+	return fmt.Sprintf("https://%s/", accountsHost)
+}
+
 func (c *DatabricksClient) api12(r *http.Request) error {
 	if r.URL == nil {
 		return fmt.Errorf("no URL found in request")
@@ -351,31 +485,77 @@ func (c *DatabricksClient) authenticatedQuery(ctx context.Context, method, reque
 	return c.genericQuery(ctx, method, requestURL, data, visitors...)
 }
 
+// redactedFields are never written to debug logs: secret values, tokens,
+// workspace file contents, SCIM passwords, Azure client secrets, PagerDuty
+// integration keys and configs of notification destinations with webhook URLs
+var redactedFields = map[string]bool{
+	"string_value":          true,
+	"bytes_value":           true,
+	"token_value":           true,
+	"content":               true,
+	"password":              true,
+	"client_secret":         true,
+	"access_token":          true,
+	"refresh_token":         true,
+	"id_token":              true,
+	"personal_access_token": true,
+	"integration_key":       true,
+	"config":                true,
+}
+
+// redactedHeaders carry credentials and are masked when debug_headers is on
+var redactedHeaders = map[string]bool{
+	"Authorization":                          true,
+	"X-Databricks-Azure-Sp-Management-Token": true,
+	"X-Databricks-Gcp-Sa-Access-Token":       true,
+}
+
+const redacted = "**REDACTED**"
+
 func (c *DatabricksClient) recursiveMask(requestMap map[string]interface{}) interface{} {
 	for k, v := range requestMap {
-		if k == "string_value" {
-			requestMap[k] = "**REDACTED**"
-			continue
-		}
-		if k == "token_value" {
-			requestMap[k] = "**REDACTED**"
-			continue
-		}
-		if k == "content" {
-			requestMap[k] = "**REDACTED**"
-			continue
+		requestMap[k] = c.maskValue(k, v)
+	}
+	return requestMap
+}
+
+func (c *DatabricksClient) maskValue(k string, v interface{}) interface{} {
+	if redactedFields[k] {
+		return redacted
+	}
+	switch x := v.(type) {
+	case map[string]interface{}:
+		return c.recursiveMask(x)
+	case []interface{}:
+		for i, item := range x {
+			x[i] = c.maskValue("", item)
 		}
-		if m, ok := v.(map[string]interface{}); ok {
-			requestMap[k] = c.recursiveMask(m)
-			continue
+		return x
+	case string:
+		if strings.HasPrefix(x, "dapi") {
+			// Databricks personal access tokens may appear in arbitrary fields
+			return redacted
 		}
-		// todo: dapi...
-		// TODO: just redact any dapiXXX & "secret": "...."...
-		if s, ok := v.(string); ok {
-			requestMap[k] = onlyNBytes(s, c.DebugTruncateBytes)
+		return onlyNBytes(x, c.DebugTruncateBytes)
+	}
+	return v
+}
+
+func (c *DatabricksClient) redactedHeaders(request *http.Request) (headers string) {
+	if !c.DebugHeaders {
+		return
+	}
+	for k, v := range request.Header {
+		value := onlyNBytes(strings.Join(v, ""), c.DebugTruncateBytes)
+		if redactedHeaders[http.CanonicalHeaderKey(k)] {
+			value = redacted
 		}
+		headers += fmt.Sprintf("\n * %s: %s", k, value)
 	}
-	return requestMap
+	if len(headers) > 0 {
+		headers += "\n"
+	}
+	return
 }
 
 func (c *DatabricksClient) redactedDump(body []byte) (res string) {
@@ -409,8 +589,12 @@ func (c *DatabricksClient) userAgent(ctx context.Context) string {
 	if c.Provider != nil {
 		terraformVersion = c.Provider.TerraformVersion
 	}
-	return fmt.Sprintf("databricks-tf-provider/%s (+%s) terraform/%s",
+	userAgent := fmt.Sprintf("databricks-tf-provider/%s (+%s) terraform/%s",
 		Version(), resource, terraformVersion)
+	if c.Partner != "" {
+		userAgent += " " + c.Partner
+	}
+	return userAgent
 }
 
 // todo: do is better name
@@ -426,6 +610,7 @@ func (c *DatabricksClient) genericQuery(ctx context.Context, method, requestURL
 	if err != nil {
 		return nil, err
 	}
+	ctx = context.WithValue(ctx, retryStartedAt, time.Now())
 	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, err
@@ -437,15 +622,7 @@ func (c *DatabricksClient) genericQuery(ctx context.Context, method, requestURL
 			return nil, err
 		}
 	}
-	headers := ""
-	if c.DebugHeaders {
-		for k, v := range request.Header {
-			headers += fmt.Sprintf("\n * %s: %s", k, onlyNBytes(strings.Join(v, ""), c.DebugTruncateBytes))
-		}
-		if len(headers) > 0 {
-			headers += "\n"
-		}
-	}
+	headers := c.redactedHeaders(request)
 	log.Printf("[DEBUG] %s %s %s%v", method, requestURL, headers, c.redactedDump(requestBody)) // lgtm[go/clear-text-logging]
 
 	r, err := retryablehttp.FromRequest(request)
@@ -453,11 +630,16 @@ func (c *DatabricksClient) genericQuery(ctx context.Context, method, requestURL
 		return nil, err
 	}
 	resp, err := c.httpClient.Do(r)
+	if ctx.Err() != nil {
+		// don't hide cancellation behind IO_ERROR, so that callers could check for it
+		err = ctx.Err()
+	}
 	// retryablehttp library now returns only wrapped errors
 	var ae APIError
 	if errors.As(err, &ae) {
-		return nil, ae
+		err = ae
 	}
+	c.auditLog(ctx, method, request.URL.Path, requestBody, err)
 	if err != nil {
 		return nil, err
 	}
@@ -505,7 +687,10 @@ func makeRequestBody(method string, requestURL *string, data interface{}, marsha
 			return requestBody, fmt.Errorf("unsupported request data: %#v", data)
 		}
 	} else {
-		if marshalJSON {
+		if raw, ok := data.([]byte); ok {
+			// already encoded body, like multipart/form-data
+			requestBody = raw
+		} else if marshalJSON {
 			bodyBytes, err := json.MarshalIndent(data, "", "  ")
 			if err != nil {
 				return nil, err
@@ -3,13 +3,16 @@ package common
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -70,6 +73,19 @@ func TestCommonErrorFromE2ClientToWorkspace(t *testing.T) {
 	assert.Nil(t, e2APIFromE2Client)
 }
 
+func TestCommonErrorFromWorkspaceClientWithAccountID(t *testing.T) {
+	ws := DatabricksClient{
+		Host:      "https://qwerty.cloud.databricks.com/",
+		AccountID: "a",
+	}
+	accountsAPIForWorkspaceClient := ws.commonErrorClarity(&http.Response{
+		Request: httptest.NewRequest(
+			"GET", "https://accounts.cloud.databricks.com/api/2.0/accounts/a/log-delivery",
+			nil),
+	})
+	assert.Nil(t, accountsAPIForWorkspaceClient)
+}
+
 type errReader int
 
 func (errReader) Read(p []byte) (n int, err error) {
@@ -202,6 +218,151 @@ func TestCheckHTTPRetry_429(t *testing.T) {
 		"Actual message: %s", err.Error())
 }
 
+func TestCheckHTTPRetry_TemporarilyUnavailable(t *testing.T) {
+	ws := DatabricksClient{
+		Host: "qwerty.cloud.databricks.com",
+	}
+	retry, err := ws.checkHTTPRetry(context.Background(), &http.Response{
+		StatusCode: 503,
+		Status:     "503 Service Unavailable",
+		Request:    httptest.NewRequest("GET", "https://qwerty.cloud.databricks.com/api/2.0/clusters/list", nil),
+		Body: ioutil.NopCloser(strings.NewReader(`{
+			"error_code": "TEMPORARILY_UNAVAILABLE",
+			"message": "Please try again later"
+		}`)),
+	}, nil)
+	assert.True(t, retry)
+	require.EqualError(t, err, "Please try again later")
+}
+
+func TestCheckHTTPRetry_Timeout(t *testing.T) {
+	retryTimeoutSeconds := 10
+	ws := DatabricksClient{
+		Host:                "qwerty.cloud.databricks.com",
+		RetryTimeoutSeconds: &retryTimeoutSeconds,
+	}
+	ctx := context.WithValue(context.Background(), retryStartedAt, time.Now().Add(-11*time.Second))
+	retry, err := ws.checkHTTPRetry(ctx, &http.Response{
+		StatusCode: 429,
+	}, nil)
+	assert.False(t, retry)
+	require.Error(t, err)
+}
+
+func TestGenericQuery_RetriesTransientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			attempts++
+			if attempts < 3 {
+				rw.WriteHeader(503)
+				_, err := rw.Write([]byte(`{"error_code": "TEMPORARILY_UNAVAILABLE", "message": "try later"}`))
+				assert.NoError(t, err)
+				return
+			}
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	maxRetries := 5
+	client := &DatabricksClient{
+		Host:       server.URL + "/",
+		Token:      "..",
+		MaxRetries: &maxRetries,
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+	client.httpClient.RetryWaitMin = 10 * time.Millisecond
+	client.httpClient.RetryWaitMax = 10 * time.Millisecond
+
+	var resp map[string]string
+	err = client.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "b", resp["a"])
+	assert.Equal(t, 3, attempts)
+}
+
+func TestGenericQuery_ZeroMaxRetriesDisablesRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			attempts++
+			rw.WriteHeader(503)
+			_, err := rw.Write([]byte(`{"error_code": "TEMPORARILY_UNAVAILABLE", "message": "try later"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	maxRetries := 0
+	client := &DatabricksClient{
+		Host:       server.URL + "/",
+		Token:      "..",
+		MaxRetries: &maxRetries,
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+
+	var resp map[string]string
+	err = client.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+	require.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestGenericQuery_RateLimitAppliesToRetries(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			attempts++
+			if attempts < 3 {
+				rw.WriteHeader(429)
+				return
+			}
+			_, err := rw.Write([]byte(`{}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	client := &DatabricksClient{
+		Host:               server.URL + "/",
+		Token:              "..",
+		RateLimitPerSecond: 5,
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+	client.httpClient.RetryWaitMin = time.Millisecond
+	client.httpClient.RetryWaitMax = time.Millisecond
+
+	started := time.Now()
+	err = client.Get(context.Background(), "/imaginary/endpoint", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	// three requests with burst of one token and 5 tokens per second
+	assert.True(t, time.Since(started) >= 350*time.Millisecond,
+		"Requests were not throttled: %s", time.Since(started))
+}
+
+func TestGenericQuery_HTTPProxy(t *testing.T) {
+	proxied := []string{}
+	proxy := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			proxied = append(proxied, req.URL.String())
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer proxy.Close()
+	client := &DatabricksClient{
+		Host:      "http://workspace.cloud.databricks.com",
+		Token:     "..",
+		HTTPProxy: proxy.URL,
+	}
+	err := client.Configure()
+	require.NoError(t, err)
+
+	var resp map[string]string
+	err = client.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "b", resp["a"])
+	assert.Equal(t, []string{"http://workspace.cloud.databricks.com/api/2.0/imaginary/endpoint"}, proxied)
+}
+
 func singleRequestServer(t *testing.T, method, url, response string) (*DatabricksClient, *httptest.Server) {
 	server := httptest.NewServer(http.HandlerFunc(
 		func(rw http.ResponseWriter, req *http.Request) {
@@ -303,6 +464,43 @@ func TestAPI2(t *testing.T) {
 		"Actual message: %s", err.Error())
 }
 
+func TestAPI2_AccountID(t *testing.T) {
+	ws := DatabricksClient{
+		Host:      "https://qwerty.cloud.databricks.com/",
+		AccountID: "a",
+	}
+	accounts := &http.Request{
+		Header: http.Header{},
+		URL: &url.URL{
+			Path: "/accounts/a/workspaces",
+		},
+	}
+	err := ws.api2(accounts)
+	require.NoError(t, err)
+	assert.Equal(t, "accounts.cloud.databricks.com", accounts.URL.Host)
+	assert.Equal(t, "/api/2.0/accounts/a/workspaces", accounts.URL.Path)
+
+	workspace := &http.Request{
+		Header: http.Header{},
+		URL: &url.URL{
+			Path: "/clusters/list",
+		},
+	}
+	err = ws.api2(workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "qwerty.cloud.databricks.com", workspace.URL.Host)
+}
+
+func TestAPI2_1(t *testing.T) {
+	ws := DatabricksClient{Host: "https://qwerty.cloud.databricks.com/"}
+	ctx := context.WithValue(context.Background(), Api, API_2_1)
+	request, err := http.NewRequestWithContext(ctx, "GET", "/jobs/get", nil)
+	require.NoError(t, err)
+	err = ws.api2(request)
+	require.NoError(t, err)
+	assert.Equal(t, "/api/2.1/jobs/get", request.URL.Path)
+}
+
 func TestScim(t *testing.T) {
 	ws, server := singleRequestServer(t, "GET", "/api/2.0/imaginary/endpoint", `{"a": "b"}`)
 	defer server.Close()
@@ -417,3 +615,144 @@ func TestClient_HandleErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestRedactedDump(t *testing.T) {
+	c := DatabricksClient{DebugTruncateBytes: 16}
+	dump := c.redactedDump([]byte(`{
+		"scope": "a",
+		"string_value": "secret",
+		"application_id": "dapi1234567890",
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"emails": [{"value": "me@example.com"}],
+		"password": "pa$$word",
+		"azure": {"client_secret": "s3cr3t"}
+	}`))
+	assert.NotContains(t, dump, `"secret"`)
+	assert.NotContains(t, dump, "dapi1234567890")
+	assert.NotContains(t, dump, "pa$$word")
+	assert.NotContains(t, dump, "s3cr3t")
+	assert.Contains(t, dump, `"string_value": "**REDACTED**"`)
+	assert.Contains(t, dump, `"client_secret": "**REDACTED**"`)
+	assert.Contains(t, dump, `"value": "me@example.com"`)
+	assert.Contains(t, dump, `"urn:ietf:params:... (26 more bytes)"`)
+}
+
+func TestRedactedHeaders(t *testing.T) {
+	c := DatabricksClient{DebugHeaders: true, DebugTruncateBytes: 96}
+	r := httptest.NewRequest("GET", "/api/2.0/clusters/list", http.NoBody)
+	r.Header.Set("Authorization", "Bearer dapi123")
+	r.Header.Set("X-Databricks-Azure-SP-Management-Token", "abc")
+	r.Header.Set("User-Agent", "test")
+	headers := c.redactedHeaders(r)
+	assert.NotContains(t, headers, "dapi123")
+	assert.NotContains(t, headers, "abc")
+	assert.Contains(t, headers, "Authorization: **REDACTED**")
+	assert.Contains(t, headers, "User-Agent: test")
+
+	c.DebugHeaders = false
+	assert.Equal(t, "", c.redactedHeaders(r))
+}
+
+func TestGenericQueryReusesConnections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	newConnections := 0
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			newConnections++
+		}
+	}
+	client := DatabricksClient{
+		Host:               server.URL,
+		Token:              "..",
+		RateLimitPerSecond: 1000,
+	}
+	require.NoError(t, client.Configure())
+	for i := 0; i < 10; i++ {
+		var resp map[string]string
+		err := client.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, newConnections)
+}
+
+func TestRetryAfterBackoff(t *testing.T) {
+	tooManyRequests := func(retryAfter string) *http.Response {
+		return &http.Response{
+			StatusCode: 429,
+			Header: http.Header{
+				"Retry-After": []string{retryAfter},
+			},
+		}
+	}
+	assert.Equal(t, 7*time.Second, retryAfterBackoff(time.Second, 10*time.Second, 0, tooManyRequests("7")))
+	assert.Equal(t, maxRetryAfter, retryAfterBackoff(time.Second, 10*time.Second, 0, tooManyRequests("3600")))
+
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	wait := retryAfterBackoff(time.Second, 10*time.Second, 0, tooManyRequests(date))
+	assert.True(t, wait > 20*time.Second && wait <= 30*time.Second, wait)
+
+	// invalid or missing header falls back to exponential backoff
+	assert.Equal(t, 4*time.Second, retryAfterBackoff(time.Second, 10*time.Second, 2, tooManyRequests("soon")))
+	assert.Equal(t, 10*time.Second, retryAfterBackoff(time.Second, 10*time.Second, 5, &http.Response{StatusCode: 500}))
+	assert.Equal(t, 1*time.Second, retryAfterBackoff(time.Second, 10*time.Second, 0, nil))
+}
+
+func TestGenericQueryRetriesTooManyRequests(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			attempts++
+			if attempts < 3 {
+				rw.Header().Set("Retry-After", "0")
+				rw.WriteHeader(429)
+				return
+			}
+			_, err := rw.Write([]byte(`{"a": "b"}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	client := DatabricksClient{
+		Host:  server.URL,
+		Token: "..",
+	}
+	require.NoError(t, client.Configure())
+	started := time.Now()
+	var resp map[string]string
+	err := client.Get(context.Background(), "/imaginary/endpoint", nil, &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "b", resp["a"])
+	assert.Equal(t, 3, attempts)
+	assert.True(t, time.Since(started) < time.Second, "Retry-After: 0 must not wait")
+}
+
+func TestGenericQueryStopsRetriesWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			attempts++
+			cancel()
+			rw.Header().Set("Retry-After", "30")
+			rw.WriteHeader(503)
+		}))
+	defer server.Close()
+	client := DatabricksClient{
+		Host:  server.URL,
+		Token: "..",
+	}
+	require.NoError(t, client.Configure())
+	started := time.Now()
+	err := client.Get(ctx, "/imaginary/endpoint", nil, nil)
+	assert.True(t, errors.Is(err, context.Canceled), "%v", err)
+	assert.Equal(t, 1, attempts)
+	assert.True(t, time.Since(started) < time.Second, "cancelled request must not wait for retry")
+
+	err = client.Get(ctx, "/imaginary/endpoint", nil, nil)
+	assert.True(t, errors.Is(err, context.Canceled), "%v", err)
+	assert.Equal(t, 1, attempts, "cancelled context must not send requests")
+}
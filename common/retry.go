@@ -0,0 +1,62 @@
+package common
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// minimal wait between polls of long-running operations, when poll interval is not configured
+	minPollInterval = 500 * time.Millisecond
+	// maximal wait between polls of long-running operations, when poll interval is not configured
+	maxPollInterval = 10 * time.Second
+	// fraction of poll interval, that is randomly added or subtracted from every wait
+	pollJitter = 0.2
+)
+
+// RetryContext calls f until it succeeds, returns non-retryable error or timeout is reached.
+// Waits between attempts grow exponentially up to 10 seconds or are equal to poll_interval_seconds
+// of provider configuration, so that large applies don't poll the API in lockstep.
+// On timeout the last retryable error is returned, like in resource.RetryContext.
+func (c *DatabricksClient) RetryContext(ctx context.Context, timeout time.Duration, f resource.RetryFunc) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		rerr := f()
+		if rerr == nil {
+			return nil
+		}
+		if !rerr.Retryable {
+			return rerr.Err
+		}
+		lastErr = rerr.Err
+		timer := time.NewTimer(c.pollInterval(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if ctx.Err() == context.Canceled {
+				return ctx.Err()
+			}
+			return lastErr
+		case <-timer.C:
+		}
+	}
+}
+
+// pollInterval returns jittered wait before the next poll
+func (c *DatabricksClient) pollInterval(attempt int) time.Duration {
+	interval := time.Duration(c.PollIntervalSeconds) * time.Second
+	if interval == 0 {
+		// 0.5s, 1s, 2s, 4s, 8s, 10s, 10s, ...
+		interval = maxPollInterval
+		if attempt < 5 {
+			interval = minPollInterval << attempt
+		}
+	}
+	jitter := (rand.Float64()*2 - 1) * pollJitter * float64(interval)
+	return interval + time.Duration(jitter)
+}
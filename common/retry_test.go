@@ -0,0 +1,60 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryContext(t *testing.T) {
+	c := &DatabricksClient{}
+	attempts := 0
+	err := c.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		attempts++
+		if attempts < 3 {
+			return resource.RetryableError(fmt.Errorf("not yet"))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+
+	err = c.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(fmt.Errorf("nope"))
+	})
+	assert.EqualError(t, err, "nope")
+}
+
+func TestRetryContext_Timeout(t *testing.T) {
+	c := &DatabricksClient{}
+	err := c.RetryContext(context.Background(), 100*time.Millisecond, func() *resource.RetryError {
+		return resource.RetryableError(fmt.Errorf("still pending"))
+	})
+	assert.EqualError(t, err, "still pending")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = c.RetryContext(ctx, time.Minute, func() *resource.RetryError {
+		return resource.RetryableError(fmt.Errorf("still pending"))
+	})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPollInterval(t *testing.T) {
+	c := &DatabricksClient{}
+	for attempt, expected := range []time.Duration{
+		500 * time.Millisecond, time.Second, 2 * time.Second,
+		4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second,
+	} {
+		interval := c.pollInterval(attempt)
+		assert.True(t, interval >= expected*8/10 && interval <= expected*12/10,
+			"attempt %d: %s", attempt, interval)
+	}
+	c.PollIntervalSeconds = 30
+	interval := c.pollInterval(100)
+	assert.True(t, interval >= 24*time.Second && interval <= 36*time.Second, interval)
+}
@@ -0,0 +1,38 @@
+package common
+
+// WithDefaultTags merges provider-level default tags into custom tags of a resource.
+// Tags configured on the resource take precedence.
+func (c *DatabricksClient) WithDefaultTags(tags map[string]string) map[string]string {
+	if len(c.DefaultTags) == 0 {
+		return tags
+	}
+	merged := map[string]string{}
+	for k, v := range c.DefaultTags {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// WithoutDefaultTags removes provider-level default tags from custom tags received from API,
+// unless they are explicitly configured on the resource, so that they never show up in diff.
+// Configured tags are expected in the form of `d.Get("custom_tags")`.
+func (c *DatabricksClient) WithoutDefaultTags(tags map[string]string, configured interface{}) map[string]string {
+	if len(c.DefaultTags) == 0 || len(tags) == 0 {
+		return tags
+	}
+	configuredTags, _ := configured.(map[string]interface{})
+	filtered := map[string]string{}
+	for k, v := range tags {
+		if _, isConfigured := configuredTags[k]; !isConfigured && c.DefaultTags[k] == v {
+			continue
+		}
+		filtered[k] = v
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDefaultTags(t *testing.T) {
+	c := DatabricksClient{}
+	assert.Nil(t, c.WithDefaultTags(nil))
+
+	c.DefaultTags = map[string]string{
+		"CostCenter": "a",
+		"Team":       "b",
+	}
+	assert.Equal(t, map[string]string{
+		"CostCenter": "a",
+		"Team":       "c",
+		"Owner":      "d",
+	}, c.WithDefaultTags(map[string]string{
+		"Team":  "c",
+		"Owner": "d",
+	}))
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	c := DatabricksClient{}
+	assert.Equal(t, map[string]string{"a": "b"},
+		c.WithoutDefaultTags(map[string]string{"a": "b"}, nil))
+
+	c.DefaultTags = map[string]string{
+		"CostCenter": "a",
+		"Team":       "b",
+	}
+	assert.Equal(t, map[string]string{
+		"Team":  "c",
+		"Owner": "d",
+	}, c.WithoutDefaultTags(map[string]string{
+		"CostCenter": "a",
+		"Team":       "c",
+		"Owner":      "d",
+	}, map[string]interface{}{
+		"Team":  "c",
+		"Owner": "d",
+	}))
+
+	// explicitly configured default tag is kept
+	assert.Equal(t, map[string]string{
+		"CostCenter": "a",
+	}, c.WithoutDefaultTags(map[string]string{
+		"CostCenter": "a",
+		"Team":       "b",
+	}, map[string]interface{}{
+		"CostCenter": "a",
+	}))
+
+	assert.Nil(t, c.WithoutDefaultTags(map[string]string{
+		"CostCenter": "a",
+		"Team":       "b",
+	}, nil))
+}
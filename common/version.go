@@ -10,10 +10,20 @@ var (
 	Provider contextKey = 2
 	// Current is the current name of integration test
 	Current contextKey = 3
+	// ResourceID is the ID of resource in Terraform state, if it's already known
+	ResourceID contextKey = 5
+	// Api is the REST API version to use for the request, if not API_2_0
+	Api contextKey = 6
 )
 
 type contextKey int
 
+// REST API versions, that could be selected with Api context key
+const (
+	API_2_0 = "2.0"
+	API_2_1 = "2.1"
+)
+
 func (k contextKey) GetOrUnknown(ctx context.Context) string {
 	rn, ok := ctx.Value(k).(string)
 	if !ok {
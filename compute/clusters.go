@@ -13,8 +13,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
+// defaultTimeout respects `timeouts {}` block of the resource, as Terraform
+// sets the deadline of the context to the create, update or delete timeout
 func (a ClustersAPI) defaultTimeout() time.Duration {
-	return 30 * time.Minute
+	return timeoutFromContext(a.context, DefaultProvisionTimeout)
+}
+
+// timeoutFromContext returns the time left till context deadline or the given default
+func timeoutFromContext(ctx context.Context, defaultTimeout time.Duration) time.Duration {
+	if ctx == nil {
+		return defaultTimeout
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return defaultTimeout
 }
 
 // NewClustersAPI creates ClustersAPI instance from provider meta
@@ -33,16 +46,15 @@ type ClustersAPI struct {
 
 // Create creates a new Spark cluster and waits till it's running
 func (a ClustersAPI) Create(cluster Cluster) (info ClusterInfo, err error) {
-	var ci ClusterID
-	err = a.client.Post(a.context, "/clusters/create", cluster, &ci)
+	created, err := a.CreateNoWait(cluster)
 	if err != nil {
 		return
 	}
-	info, err = a.waitForClusterStatus(ci.ClusterID, ClusterStateRunning)
+	info, err = a.waitForClusterStatus(created.ClusterID, ClusterStateRunning)
 	if err != nil {
 		// https://github.com/databrickslabs/terraform-provider-databricks/issues/383
 		log.Printf("[ERROR] Cleaning up created cluster, that failed to start: %s", err.Error())
-		deleteErr := a.PermanentDelete(ci.ClusterID)
+		deleteErr := a.PermanentDelete(created.ClusterID)
 		if deleteErr != nil {
 			log.Printf("[ERROR] Failed : %s", deleteErr.Error())
 			err = deleteErr
@@ -51,6 +63,20 @@ func (a ClustersAPI) Create(cluster Cluster) (info ClusterInfo, err error) {
 	return
 }
 
+// CreateNoWait creates a new Spark cluster without waiting for it to start.
+// Only ClusterID / State properties are valid in returned info
+func (a ClustersAPI) CreateNoWait(cluster Cluster) (info ClusterInfo, err error) {
+	var ci ClusterID
+	cluster.CustomTags = a.client.WithDefaultTags(cluster.CustomTags)
+	err = a.client.Post(a.context, "/clusters/create", cluster, &ci)
+	if err != nil {
+		return
+	}
+	info.ClusterID = ci.ClusterID
+	info.State = ClusterStatePending
+	return
+}
+
 // Edit edits the configuration of a cluster to match the provided attributes and size
 func (a ClustersAPI) Edit(cluster Cluster) (info ClusterInfo, err error) {
 	info, err = a.Get(cluster.ClusterID)
@@ -78,6 +104,7 @@ func (a ClustersAPI) Edit(cluster Cluster) (info ClusterInfo, err error) {
 		// we don't know what to do, so return error
 		return info, fmt.Errorf("unexpected state: %#v", info.StateMessage)
 	}
+	cluster.CustomTags = a.client.WithDefaultTags(cluster.CustomTags)
 	err = a.client.Post(a.context, "/clusters/edit", cluster, nil)
 	if err != nil {
 		return info, err
@@ -90,6 +117,15 @@ func (a ClustersAPI) Edit(cluster Cluster) (info ClusterInfo, err error) {
 	return info, err
 }
 
+// Resize changes number of workers of a running cluster and waits for it to be running again
+func (a ClustersAPI) Resize(resizeRequest ResizeRequest) (info ClusterInfo, err error) {
+	err = a.client.Post(a.context, "/clusters/resize", resizeRequest, nil)
+	if err != nil {
+		return info, err
+	}
+	return a.waitForClusterStatus(resizeRequest.ClusterID, ClusterStateRunning)
+}
+
 // ListZones returns the zones info sent by the cloud service provider
 func (a ClustersAPI) ListZones() (ZonesInfo, error) {
 	var zonesInfo ZonesInfo
@@ -165,7 +201,7 @@ func wrapMissingClusterError(err error, id string) error {
 func (a ClustersAPI) waitForClusterStatus(clusterID string, desired ClusterState) (result ClusterInfo, err error) {
 	// this tangles client with terraform more, which is inevitable
 	// nolint should be a bigger context-aware refactor
-	return result, resource.RetryContext(a.context, a.defaultTimeout(), func() *resource.RetryError {
+	return result, a.client.RetryContext(a.context, a.defaultTimeout(), func() *resource.RetryError {
 		clusterInfo, err := a.Get(clusterID)
 		if ae, ok := err.(common.APIError); ok && ae.IsMissing() {
 			log.Printf("[INFO] Cluster %s not found. Retrying", clusterID)
@@ -373,6 +409,7 @@ type NodeTypeRequest struct {
 	PhotonDriverCapable   bool   `json:"photon_driver_capable,omitempty"`
 	IsIOCacheEnabled      bool   `json:"is_io_cache_enabled,omitempty"`
 	SupportPortForwarding bool   `json:"support_port_forwarding,omitempty"`
+	Fleet                 bool   `json:"fleet,omitempty"`
 }
 
 func defaultSmallestNodeType(a ClustersAPI) string {
@@ -384,6 +421,12 @@ func defaultSmallestNodeType(a ClustersAPI) string {
 	return "i3.xlarge"
 }
 
+// isFleetNodeType tells if node type is AWS fleet, like `md-fleet.xlarge`,
+// that draws capacity from multiple instance types
+func isFleetNodeType(nodeTypeID string) bool {
+	return strings.Contains(nodeTypeID, "-fleet.")
+}
+
 // GetSmallestNodeType returns smallest (or default) node type id given the criteria
 func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 	list, _ := a.ListNodeTypes()
@@ -427,6 +470,9 @@ func (a ClustersAPI) GetSmallestNodeType(r NodeTypeRequest) string {
 		if r.PhotonWorkerCapable && nt.PhotonWorkerCapable != r.PhotonWorkerCapable {
 			continue
 		}
+		if r.Fleet && !isFleetNodeType(nt.NodeTypeID) {
+			continue
+		}
 		return nt.NodeTypeID
 	}
 	return defaultSmallestNodeType(a)
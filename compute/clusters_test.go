@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
@@ -215,6 +216,58 @@ func TestWaitForClusterStatus_StopRetryingEarly(t *testing.T) {
 	require.Contains(t, err.Error(), "I am a teapot")
 }
 
+func TestWaitForClusterStatus_RespectsContextDeadline(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				State: ClusterStatePending,
+			},
+		},
+	})
+	defer server.Close()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	clustersAPI := NewClustersAPI(ctx, client)
+	assert.True(t, clustersAPI.defaultTimeout() <= 500*time.Millisecond)
+	_, err = clustersAPI.waitForClusterStatus("abc", ClusterStateRunning)
+	require.Error(t, err)
+}
+
+func TestWaitForClusterStatus_StopsWhenCancelled(t *testing.T) {
+	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				State: ClusterStatePending,
+			},
+		},
+	})
+	defer server.Close()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(200*time.Millisecond, cancel)
+	started := time.Now()
+	_, err = NewClustersAPI(ctx, client).waitForClusterStatus("abc", ClusterStateRunning)
+	require.Error(t, err)
+	assert.True(t, time.Since(started) < 5*time.Second, "polling must stop after cancellation")
+}
+
+func TestTimeoutFromContext(t *testing.T) {
+	assert.Equal(t, DefaultProvisionTimeout, timeoutFromContext(context.Background(), DefaultProvisionTimeout))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	timeout := timeoutFromContext(ctx, DefaultProvisionTimeout)
+	assert.True(t, timeout > 59*time.Minute && timeout <= time.Hour, timeout)
+}
+
 func TestWaitForClusterStatus_NotReachable(t *testing.T) {
 	client, server, err := qa.HttpFixtureClient(t, []qa.HTTPFixture{
 		{
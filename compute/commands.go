@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -26,8 +27,8 @@ type CommandsAPI struct {
 	context context.Context
 }
 
-// Execute creates a spark context and executes a command and then closes context
-// Any leading whitespace is trimmed
+// Execute runs a command in execution context, that is reused by subsequent commands
+// on the same cluster and language. Any leading whitespace is trimmed
 func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.CommandResults {
 	cluster, err := NewClustersAPI(a.context, a.client).Get(clusterID)
 	if err != nil {
@@ -44,57 +45,138 @@ func (a CommandsAPI) Execute(clusterID, language, commandStr string) common.Comm
 	}
 	commandStr = internal.TrimLeadingWhitespace(commandStr)
 	log.Printf("[INFO] Executing %s command on %s:\n%s", language, clusterID, commandStr)
-	context, err := a.createContext(language, clusterID)
+	command, err := a.executeInContext(clusterID, language, commandStr)
 	if err != nil {
 		return common.CommandResults{
 			ResultType: "error",
 			Summary:    err.Error(),
 		}
 	}
-	err = a.waitForContextReady(context, clusterID)
-	if err != nil {
+	if command.Results == nil {
+		log.Printf("[ERROR] Command has no results: %#v", command)
 		return common.CommandResults{
 			ResultType: "error",
-			Summary:    err.Error(),
+			Summary:    "Command has no results",
 		}
 	}
-	commandID, err := a.createCommand(context, clusterID, language, commandStr)
+	return *command.Results
+}
+
+func (a CommandsAPI) executeInContext(clusterID, language, commandStr string) (command Command, err error) {
+	contextID, reused, err := a.acquireContext(clusterID, language)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
+		return
+	}
+	commandID, err := a.createCommand(contextID, clusterID, language, commandStr)
+	if err != nil && reused {
+		// cached context is gone, e.g. after cluster restart
+		log.Printf("[INFO] Execution context %s is no longer available: %s", contextID, err)
+		contextID, err = a.newContext(clusterID, language)
+		if err != nil {
+			return
 		}
+		commandID, err = a.createCommand(contextID, clusterID, language, commandStr)
+	}
+	if err != nil {
+		a.discardContext(contextID, clusterID)
+		return
 	}
 	// TODO: merge getCommand and waitForCommandFinished to "waitForCommandResults"
-	err = a.waitForCommandFinished(commandID, context, clusterID)
+	err = a.waitForCommandFinished(commandID, contextID, clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		a.discardContext(contextID, clusterID)
+		return
 	}
-	command, err := a.getCommand(commandID, context, clusterID)
+	command, err = a.getCommand(commandID, contextID, clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
+		a.discardContext(contextID, clusterID)
+		return
+	}
+	a.releaseContext(contextID, clusterID, language)
+	return
+}
+
+// executionContextKey identifies idle execution contexts, that could be reused
+type executionContextKey struct {
+	client    *common.DatabricksClient
+	clusterID string
+	language  string
+}
+
+// maxIdleContexts limits the number of execution contexts kept open for every cluster
+// and language, because clusters have a limit on the number of open execution contexts
+const maxIdleContexts = 1
+
+var (
+	// idle execution contexts, so that every command doesn't wait for a new one
+	idleContexts     = map[executionContextKey][]string{}
+	idleContextsLock sync.Mutex
+)
+
+// acquireContext takes idle execution context or creates a new one, so that
+// concurrent commands never share the same context
+func (a CommandsAPI) acquireContext(clusterID, language string) (string, bool, error) {
+	key := executionContextKey{a.client, clusterID, language}
+	idleContextsLock.Lock()
+	if idle := idleContexts[key]; len(idle) > 0 {
+		contextID := idle[len(idle)-1]
+		idleContexts[key] = idle[:len(idle)-1]
+		idleContextsLock.Unlock()
+		return contextID, true, nil
+	}
+	idleContextsLock.Unlock()
+	contextID, err := a.newContext(clusterID, language)
+	return contextID, false, err
+}
+
+// releaseContext makes execution context available for the next command or destroys it,
+// if there are enough idle contexts for the same cluster and language
+func (a CommandsAPI) releaseContext(contextID, clusterID, language string) {
+	key := executionContextKey{a.client, clusterID, language}
+	idleContextsLock.Lock()
+	if len(idleContexts[key]) < maxIdleContexts {
+		idleContexts[key] = append(idleContexts[key], contextID)
+		idleContextsLock.Unlock()
+		return
+	}
+	idleContextsLock.Unlock()
+	a.discardContext(contextID, clusterID)
+}
+
+// DestroyIdleContexts destroys all cached execution contexts. It is called when provider
+// shuts down, so that repeated runs don't leave execution contexts open on shared clusters.
+func DestroyIdleContexts(ctx context.Context) {
+	idleContextsLock.Lock()
+	idle := idleContexts
+	idleContexts = map[executionContextKey][]string{}
+	idleContextsLock.Unlock()
+	for key, contextIDs := range idle {
+		a := NewCommandsAPI(ctx, key.client)
+		for _, contextID := range contextIDs {
+			a.discardContext(contextID, key.clusterID)
 		}
 	}
-	err = a.deleteContext(context, clusterID)
+}
+
+// discardContext destroys execution context, that may be in a broken state
+func (a CommandsAPI) discardContext(contextID, clusterID string) {
+	err := a.deleteContext(contextID, clusterID)
 	if err != nil {
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    err.Error(),
-		}
+		log.Printf("[WARN] Cannot destroy execution context %s: %s", contextID, err)
 	}
-	if command.Results == nil {
-		log.Printf("[ERROR] Command has no results: %#v", command)
-		return common.CommandResults{
-			ResultType: "error",
-			Summary:    "Command has no results",
-		}
+}
+
+func (a CommandsAPI) newContext(clusterID, language string) (string, error) {
+	contextID, err := a.createContext(language, clusterID)
+	if err != nil {
+		return "", err
 	}
-	return *command.Results
+	err = a.waitForContextReady(contextID, clusterID)
+	if err != nil {
+		a.discardContext(contextID, clusterID)
+		return "", err
+	}
+	return contextID, nil
 }
 
 type genericCommandRequest struct {
@@ -152,7 +234,7 @@ func (a CommandsAPI) createContext(language, clusterID string) (string, error) {
 }
 
 func (a CommandsAPI) waitForCommandFinished(commandID, contextID, clusterID string) error {
-	return resource.RetryContext(a.context, 10*time.Minute, func() *resource.RetryError {
+	return a.client.RetryContext(a.context, timeoutFromContext(a.context, 10*time.Minute), func() *resource.RetryError {
 		commandInfo, err := a.getCommand(commandID, contextID, clusterID)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -169,7 +251,7 @@ func (a CommandsAPI) waitForCommandFinished(commandID, contextID, clusterID stri
 }
 
 func (a CommandsAPI) waitForContextReady(contextID, clusterID string) error {
-	return resource.RetryContext(a.context, 10*time.Minute, func() *resource.RetryError {
+	return a.client.RetryContext(a.context, timeoutFromContext(a.context, 10*time.Minute), func() *resource.RetryError {
 		status, err := a.getContext(contextID, clusterID)
 		if err != nil {
 			return resource.NonRetryableError(err)
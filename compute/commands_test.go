@@ -69,14 +69,6 @@ func commonFixtureWithStatusResponse(response Command) []qa.HTTPFixture {
 			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=234&contextId=123",
 			Response:     response,
 		},
-		{
-			Method:   "POST",
-			Resource: "/api/1.2/contexts/destroy",
-			ExpectedRequest: genericCommandRequest{
-				ClusterID: "abc",
-				ContextID: "123",
-			},
-		},
 	}
 }
 
@@ -269,6 +261,14 @@ func TestCommandsAPIExecute_FailToWaitForContext(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -307,6 +307,14 @@ func TestCommandsAPIExecute_FailToCreateCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -352,6 +360,14 @@ func TestCommandsAPIExecute_FailToWaitForCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -404,6 +420,14 @@ func TestCommandsAPIExecute_FailToGetCommand(t *testing.T) {
 				Message: "Does not compute",
 			},
 		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "abc",
+			},
+		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
@@ -411,11 +435,12 @@ func TestCommandsAPIExecute_FailToGetCommand(t *testing.T) {
 	})
 }
 
-func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
+func TestCommandsAPIExecute_ReusesContext(t *testing.T) {
 	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
-			Method:   "GET",
-			Resource: "/api/2.0/clusters/get?cluster_id=abc",
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
 			Response: ClusterInfo{
 				State: "RUNNING",
 			},
@@ -434,9 +459,83 @@ func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
 				Status: "Running",
 			},
 		},
+		{
+			Method:       "POST",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/execute",
+			Response: Command{
+				ID: "abc",
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=abc&contextId=abc",
+			Response: Command{
+				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		commands := NewCommandsAPI(ctx, client)
+		cr := commands.Execute("abc", "python", "print('done')")
+		assert.Equal(t, "done", cr.Text())
+		// contexts/create fixture is not reusable, so this fails if context is not cached
+		cr = commands.Execute("abc", "python", "print('done')")
+		assert.Equal(t, "done", cr.Text())
+	})
+}
+
+func TestCommandsAPIExecute_RecreatesExpiredContext(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+			Response: ClusterInfo{
+				State: "RUNNING",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/create",
+			Response: Command{
+				ID: "new",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/1.2/contexts/status?clusterId=abc&contextId=new",
+			Response: Command{
+				Status: "Running",
+			},
+		},
 		{
 			Method:   "POST",
 			Resource: "/api/1.2/commands/execute",
+			ExpectedRequest: genericCommandRequest{
+				Language:  "python",
+				ClusterID: "abc",
+				ContextID: "expired",
+				Command:   "print('done')\n",
+			},
+			Status: 400,
+			Response: common.APIErrorBody{
+				API12Error: "ContextNotFound: Context expired not found",
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/commands/execute",
+			ExpectedRequest: genericCommandRequest{
+				Language:  "python",
+				ClusterID: "abc",
+				ContextID: "new",
+				Command:   "print('done')\n",
+			},
 			Response: Command{
 				ID: "abc",
 			},
@@ -444,23 +543,65 @@ func TestCommandsAPIExecute_FailToDeleteContext(t *testing.T) {
 		{
 			Method:       "GET",
 			ReuseRequest: true,
-			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=abc&contextId=abc",
+			Resource:     "/api/1.2/commands/status?clusterId=abc&commandId=abc&contextId=new",
 			Response: Command{
 				Status: "Finished",
+				Results: &common.CommandResults{
+					ResultType: "text",
+					Data:       "done",
+				},
 			},
 		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		commands := NewCommandsAPI(ctx, client)
+		commands.releaseContext("expired", "abc", "python")
+		cr := commands.Execute("abc", "python", "print('done')")
+		assert.Equal(t, "done", cr.Text())
+	})
+}
+
+func TestCommandsAPIReleaseContext_DestroysExcessContexts(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
 		{
 			Method:   "POST",
 			Resource: "/api/1.2/contexts/destroy",
-			Status:   417,
-			Response: common.APIError{
-				Message: "Does not compute",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "second",
 			},
 		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
-		cr := commands.Execute("abc", "cobol", "Hello?")
-		assert.EqualError(t, cr.Err(), "Does not compute")
+		commands.releaseContext("first", "abc", "python")
+		commands.releaseContext("second", "abc", "python")
+		assert.Equal(t, []string{"first"},
+			idleContexts[executionContextKey{client, "abc", "python"}])
+	})
+}
+
+func TestDestroyIdleContexts(t *testing.T) {
+	// other tests leave contexts of already stopped servers in cache
+	idleContextsLock.Lock()
+	previous := idleContexts
+	idleContexts = map[executionContextKey][]string{}
+	idleContextsLock.Unlock()
+	defer func() {
+		idleContexts = previous
+	}()
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/1.2/contexts/destroy",
+			ExpectedRequest: genericCommandRequest{
+				ClusterID: "abc",
+				ContextID: "python-context",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		commands := NewCommandsAPI(ctx, client)
+		commands.releaseContext("python-context", "abc", "python")
+		DestroyIdleContexts(ctx)
+		assert.Len(t, idleContexts, 0)
 	})
 }
 
@@ -502,10 +643,6 @@ func TestCommandsAPIExecute_NoCommandResults(t *testing.T) {
 				Status: "Finished",
 			},
 		},
-		{
-			Method:   "POST",
-			Resource: "/api/1.2/contexts/destroy",
-		},
 	}, func(ctx context.Context, client *common.DatabricksClient) {
 		commands := NewCommandsAPI(ctx, client)
 		cr := commands.Execute("abc", "cobol", "Hello?")
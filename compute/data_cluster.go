@@ -0,0 +1,73 @@
+package compute
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceCluster resolves a single existing cluster by its name
+func DataSourceCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			clusterName := d.Get("cluster_name").(string)
+			clusters, err := NewClustersAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			matching := []ClusterInfo{}
+			for _, cluster := range clusters {
+				if cluster.ClusterName == clusterName {
+					matching = append(matching, cluster)
+				}
+			}
+			if len(matching) == 0 {
+				return diag.Errorf("there is no cluster named '%s'", clusterName)
+			}
+			if len(matching) > 1 {
+				return diag.Errorf("there are %d clusters named '%s'", len(matching), clusterName)
+			}
+			cluster := matching[0]
+			d.SetId(cluster.ClusterID)
+			for k, v := range map[string]interface{}{
+				"cluster_id":          cluster.ClusterID,
+				"state":               string(cluster.State),
+				"spark_version":       cluster.SparkVersion,
+				"node_type_id":        cluster.NodeTypeID,
+				"driver_node_type_id": cluster.DriverNodeTypeID,
+			} {
+				if err = d.Set(k, v); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"spark_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"driver_node_type_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,131 @@
+package compute
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceClusterEvents returns events of a cluster, like spot terminations or failed resizes
+func DataSourceClusterEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			clusterID := d.Get("cluster_id").(string)
+			request := EventsRequest{
+				ClusterID: clusterID,
+				StartTime: int64(d.Get("start_time").(int)),
+				EndTime:   int64(d.Get("end_time").(int)),
+				Order:     SortOrder(d.Get("order").(string)),
+				MaxItems:  uint(d.Get("max_items").(int)),
+			}
+			for _, v := range d.Get("event_types").([]interface{}) {
+				request.EventTypes = append(request.EventTypes, ClusterEventType(v.(string)))
+			}
+			events, err := NewClustersAPI(ctx, m).Events(request)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			eventList := []map[string]interface{}{}
+			for _, event := range events {
+				eventData := map[string]interface{}{
+					"timestamp":           int(event.Timestamp),
+					"type":                string(event.Type),
+					"current_num_workers": int(event.Details.CurrentNumWorkers),
+					"target_num_workers":  int(event.Details.TargetNumWorkers),
+					"user":                event.Details.User,
+				}
+				if event.Details.ResizeCause != nil {
+					eventData["cause"] = string(*event.Details.ResizeCause)
+				}
+				if event.Details.Reason != nil {
+					eventData["reason_code"] = event.Details.Reason.Code
+					eventData["reason_type"] = event.Details.Reason.Type
+				}
+				eventList = append(eventList, eventData)
+			}
+			d.SetId(clusterID)
+			if err = d.Set("events", eventList); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"event_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"start_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"end_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"order": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(SortDescending),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(SortDescending),
+					string(SortAscending),
+				}, false),
+			},
+			"max_items": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_num_workers": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target_num_workers": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cause": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
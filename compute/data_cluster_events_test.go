@@ -0,0 +1,87 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterEvents(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					StartTime:  1000,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypeNodesLost, EvTypeTerminating},
+				},
+				Response: EventsResponse{
+					Events: []ClusterEvent{
+						{
+							ClusterID: "abc",
+							Timestamp: 2000,
+							Type:      EvTypeTerminating,
+							Details: EventDetails{
+								Reason: &TerminationReason{
+									Code: "SPOT_INSTANCE_TERMINATION",
+									Type: "CLOUD_FAILURE",
+								},
+							},
+						},
+						{
+							ClusterID: "abc",
+							Timestamp: 1500,
+							Type:      EvTypeNodesLost,
+							Details: EventDetails{
+								CurrentNumWorkers: 3,
+								TargetNumWorkers:  4,
+							},
+						},
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceClusterEvents(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		cluster_id = "abc"
+		start_time = 1000
+		event_types = ["NODES_LOST", "TERMINATING"]`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 2, d.Get("events.#"))
+	assert.Equal(t, "TERMINATING", d.Get("events.0.type"))
+	assert.Equal(t, "SPOT_INSTANCE_TERMINATION", d.Get("events.0.reason_code"))
+	assert.Equal(t, 2000, d.Get("events.0.timestamp"))
+	assert.Equal(t, 3, d.Get("events.1.current_num_workers"))
+}
+
+func TestClusterEvents_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Cluster abc does not exist",
+				},
+				Status: 400,
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceClusterEvents(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `cluster_id = "abc"`,
+	}.ExpectError(t, "Cluster abc does not exist")
+}
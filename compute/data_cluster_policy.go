@@ -0,0 +1,76 @@
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// mergePolicyDefinition replaces rules of the base policy definition with rules from overrides.
+// Rules are replaced as a whole, so that `fixed` rule could be replaced with `range` one
+func mergePolicyDefinition(base, overrides string) (string, error) {
+	if overrides == "" {
+		return base, nil
+	}
+	definition := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(base), &definition); err != nil {
+		return "", fmt.Errorf("cannot parse policy definition: %w", err)
+	}
+	overridden := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(overrides), &overridden); err != nil {
+		return "", fmt.Errorf("cannot parse definition overrides: %w", err)
+	}
+	for k, v := range overridden {
+		definition[k] = v
+	}
+	merged, err := json.Marshal(definition)
+	return string(merged), err
+}
+
+// DataSourceClusterPolicy returns cluster policy by its name, optionally with overridden rules
+func DataSourceClusterPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			name := d.Get("name").(string)
+			policies, err := NewClusterPoliciesAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			for _, policy := range policies {
+				if policy.Name != name {
+					continue
+				}
+				definition, err := mergePolicyDefinition(policy.Definition,
+					d.Get("definition_overrides").(string))
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.SetId(policy.PolicyID)
+				if err = d.Set("definition", definition); err != nil {
+					return diag.FromErr(err)
+				}
+				return nil
+			}
+			return diag.Errorf("cluster policy '%s' wasn't found", name)
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"definition_overrides": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,71 @@
+package compute
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var policyListFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/policies/clusters/list",
+	Response: ClusterPolicyList{
+		Policies: []ClusterPolicy{
+			{
+				PolicyID:   "abc",
+				Name:       "Personal Compute",
+				Definition: `{"node_type_id":{"type":"fixed","value":"i3.xlarge"},"autotermination_minutes":{"type":"fixed","value":60}}`,
+			},
+		},
+		TotalCount: 1,
+	},
+}
+
+func TestClusterPolicyData(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policyListFixture},
+		Read:        true,
+		Resource:    DataSourceClusterPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `name = "Personal Compute"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, `{"node_type_id":{"type":"fixed","value":"i3.xlarge"},"autotermination_minutes":{"type":"fixed","value":60}}`,
+		d.Get("definition"))
+}
+
+func TestClusterPolicyData_Overrides(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policyListFixture},
+		Read:        true,
+		Resource:    DataSourceClusterPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		name = "Personal Compute"
+		definition_overrides = "{\"autotermination_minutes\":{\"type\":\"range\",\"maxValue\":120}}"`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	var definition map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(d.Get("definition").(string)), &definition))
+	assert.Equal(t, "fixed", definition["node_type_id"]["type"])
+	assert.Equal(t, "range", definition["autotermination_minutes"]["type"])
+	assert.Equal(t, 120.0, definition["autotermination_minutes"]["maxValue"])
+	assert.Nil(t, definition["autotermination_minutes"]["value"])
+}
+
+func TestClusterPolicyData_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{policyListFixture},
+		Read:        true,
+		Resource:    DataSourceClusterPolicy(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `name = "Job Compute"`,
+	}.ExpectError(t, "cluster policy 'Job Compute' wasn't found")
+}
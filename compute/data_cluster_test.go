@@ -0,0 +1,78 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterDataByName(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{
+							ClusterID:    "a",
+							ClusterName:  "Shared Autoscaling",
+							State:        ClusterStateRunning,
+							SparkVersion: "7.3.x-scala2.12",
+							NodeTypeID:   "i3.xlarge",
+						},
+						{
+							ClusterID:   "b",
+							ClusterName: "Shared Pool",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceCluster(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `cluster_name = "Shared Autoscaling"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "a", d.Id())
+	assert.Equal(t, "a", d.Get("cluster_id"))
+	assert.Equal(t, "RUNNING", d.Get("state"))
+	assert.Equal(t, "7.3.x-scala2.12", d.Get("spark_version"))
+	assert.Equal(t, "i3.xlarge", d.Get("node_type_id"))
+}
+
+func TestClusterDataByName_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{clusterListFixture},
+		Read:        true,
+		Resource:    DataSourceCluster(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `cluster_name = "Unknown"`,
+	}.ExpectError(t, "there is no cluster named 'Unknown'")
+}
+
+func TestClusterDataByName_Duplicate(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/list",
+				Response: ClusterList{
+					Clusters: []ClusterInfo{
+						{ClusterID: "a", ClusterName: "Shared"},
+						{ClusterID: "b", ClusterName: "Shared"},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceCluster(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `cluster_name = "Shared"`,
+	}.ExpectError(t, "there are 2 clusters named 'Shared'")
+}
@@ -0,0 +1,87 @@
+package compute
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceClusters returns clusters of the workspace, optionally filtered by name or source
+func DataSourceClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			clusters, err := NewClustersAPI(ctx, m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			nameContains := strings.ToLower(d.Get("cluster_name_contains").(string))
+			source := d.Get("cluster_source").(string)
+			ids := []string{}
+			clusterList := []map[string]interface{}{}
+			for _, cluster := range clusters {
+				if nameContains != "" && !strings.Contains(strings.ToLower(cluster.ClusterName), nameContains) {
+					continue
+				}
+				if source != "" && string(cluster.ClusterSource) != source {
+					continue
+				}
+				ids = append(ids, cluster.ClusterID)
+				clusterList = append(clusterList, map[string]interface{}{
+					"cluster_id":     cluster.ClusterID,
+					"cluster_name":   cluster.ClusterName,
+					"state":          string(cluster.State),
+					"cluster_source": string(cluster.ClusterSource),
+				})
+			}
+			d.SetId("_")
+			if err = d.Set("ids", ids); err != nil {
+				return diag.FromErr(err)
+			}
+			if err = d.Set("clusters", clusterList); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"cluster_name_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cluster_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
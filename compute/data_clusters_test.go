@@ -0,0 +1,66 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+var clusterListFixture = qa.HTTPFixture{
+	Method:   "GET",
+	Resource: "/api/2.0/clusters/list",
+	Response: ClusterList{
+		Clusters: []ClusterInfo{
+			{
+				ClusterID:     "a",
+				ClusterName:   "Shared Autoscaling",
+				State:         ClusterStateRunning,
+				ClusterSource: "UI",
+			},
+			{
+				ClusterID:     "b",
+				ClusterName:   "job-1-run-2",
+				State:         ClusterStateTerminated,
+				ClusterSource: "JOB",
+			},
+			{
+				ClusterID:     "c",
+				ClusterName:   "Shared Pool",
+				State:         ClusterStateTerminated,
+				ClusterSource: "API",
+			},
+		},
+	},
+}
+
+func TestClusters(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{clusterListFixture},
+		Read:        true,
+		Resource:    DataSourceClusters(),
+		NonWritable: true,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 3, d.Get("ids").(*schema.Set).Len())
+	assert.Equal(t, "job-1-run-2", d.Get("clusters.1.cluster_name"))
+	assert.Equal(t, "TERMINATED", d.Get("clusters.1.state"))
+}
+
+func TestClusters_Filtered(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures:    []qa.HTTPFixture{clusterListFixture},
+		Read:        true,
+		Resource:    DataSourceClusters(),
+		NonWritable: true,
+		ID:          ".",
+		HCL: `
+		cluster_name_contains = "shared"
+		cluster_source = "API"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, []interface{}{"c"}, d.Get("ids").(*schema.Set).List())
+	assert.Equal(t, "Shared Pool", d.Get("clusters.0.cluster_name"))
+}
@@ -0,0 +1,73 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// hasTags checks if job is tagged with every one of the given tags
+func (js *JobSettings) hasTags(tags map[string]interface{}) bool {
+	for k, v := range tags {
+		if actual, ok := js.Tags[k]; !ok || actual != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// DataSourceJobs returns map of job names to their IDs, optionally filtered by name and tags
+func DataSourceJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			tags := d.Get("tags").(map[string]interface{})
+			// job tags are returned only by Jobs API 2.1
+			jobs, err := NewJobsAPI(jobsAPIContext(ctx, len(tags) > 0), m).List()
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			nameContains := strings.ToLower(d.Get("job_name_contains").(string))
+			ids := map[string]string{}
+			for _, job := range jobs.Jobs {
+				if job.Settings == nil {
+					continue
+				}
+				name := job.Settings.Name
+				if nameContains != "" && !strings.Contains(strings.ToLower(name), nameContains) {
+					continue
+				}
+				if !job.Settings.hasTags(tags) {
+					continue
+				}
+				if _, duplicate := ids[name]; duplicate {
+					return diag.Errorf("duplicate job name detected: %s", name)
+				}
+				ids[name] = fmt.Sprint(job.JobID)
+			}
+			d.SetId("_")
+			if err = d.Set("ids", ids); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"job_name_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
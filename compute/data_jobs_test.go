@@ -0,0 +1,201 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/list",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 123,
+							Settings: &JobSettings{
+								Name: "First",
+							},
+						},
+						{
+							JobID: 234,
+							Settings: &JobSettings{
+								Name: "Second",
+							},
+						},
+					},
+					HasMore: true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/list?offset=2",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 345,
+							Settings: &JobSettings{
+								Name: "Third",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceJobs(),
+		NonWritable: true,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, map[string]interface{}{
+		"First":  "123",
+		"Second": "234",
+		"Third":  "345",
+	}, d.Get("ids"))
+}
+
+func TestJobs_Filtered(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/list",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 123,
+							Settings: &JobSettings{
+								Name: "Nightly ETL",
+							},
+						},
+						{
+							JobID: 234,
+							Settings: &JobSettings{
+								Name: "Hourly Report",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceJobs(),
+		NonWritable: true,
+		ID:          ".",
+		HCL:         `job_name_contains = "etl"`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, map[string]interface{}{
+		"Nightly ETL": "123",
+	}, d.Get("ids"))
+}
+
+func TestJobs_FilteredByTags(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/list",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 123,
+							Settings: &JobSettings{
+								Name: "Nightly ETL",
+								Tags: map[string]string{
+									"team":        "data",
+									"cost-center": "42",
+								},
+							},
+						},
+						{
+							JobID: 234,
+							Settings: &JobSettings{
+								Name: "Hourly Report",
+								Tags: map[string]string{
+									"team": "bi",
+								},
+							},
+						},
+						{
+							JobID: 345,
+							Settings: &JobSettings{
+								Name: "Untagged",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceJobs(),
+		NonWritable: true,
+		ID:          ".",
+		State: map[string]interface{}{
+			"tags": map[string]interface{}{
+				"team": "data",
+			},
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, map[string]interface{}{
+		"Nightly ETL": "123",
+	}, d.Get("ids"))
+}
+
+func TestJobs_DuplicateName(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/list",
+				Response: JobList{
+					Jobs: []Job{
+						{
+							JobID: 123,
+							Settings: &JobSettings{
+								Name: "Same",
+							},
+						},
+						{
+							JobID: 234,
+							Settings: &JobSettings{
+								Name: "Same",
+							},
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceJobs(),
+		NonWritable: true,
+		ID:          ".",
+	}.ExpectError(t, "duplicate job name detected: Same")
+}
+
+func TestJobs_Error(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/list",
+				Response: common.APIErrorBody{
+					ErrorCode: "INVALID_REQUEST",
+					Message:   "Internal error happened",
+				},
+				Status: 400,
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceJobs(),
+		NonWritable: true,
+		ID:          ".",
+	}.ExpectError(t, "Internal error happened")
+}
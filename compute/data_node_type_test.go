@@ -109,3 +109,44 @@ func TestNodeType(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Random_03", d.Id())
 }
+
+func TestNodeTypeFleet(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response: NodeTypeList{
+					[]NodeType{
+						{
+							NodeTypeID:     "m5d.large",
+							InstanceTypeID: "m5d.large",
+							MemoryMB:       8192,
+							NumCores:       2,
+						},
+						{
+							NodeTypeID:     "md-fleet.xlarge",
+							InstanceTypeID: "md-fleet.xlarge",
+							MemoryMB:       16384,
+							NumCores:       4,
+						},
+						{
+							NodeTypeID:     "m-fleet.2xlarge",
+							InstanceTypeID: "m-fleet.2xlarge",
+							MemoryMB:       32768,
+							NumCores:       8,
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		Resource:    DataSourceNodeType(),
+		NonWritable: true,
+		HCL:         `fleet = true`,
+		ID:          ".",
+	}.Apply(t)
+	assert.NoError(t, err)
+	assert.Equal(t, "md-fleet.xlarge", d.Id())
+}
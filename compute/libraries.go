@@ -84,10 +84,12 @@ func (cll *ClusterLibraryList) Diff(cls ClusterLibraryStatuses) (ClusterLibraryL
 		inConfig[key] = lib
 	}
 	inState := map[string]Library{}
+	forAllClusters := map[string]bool{}
 	for _, status := range cls.LibraryStatuses {
 		lib := *status.Library
 		_, key := lib.TypeAndKey()
 		inState[key] = lib
+		forAllClusters[key] = status.IsLibraryInstalledOnAllClusters
 	}
 	toInstall := ClusterLibraryList{ClusterID: cll.ClusterID}
 	toUninstall := ClusterLibraryList{ClusterID: cll.ClusterID}
@@ -100,7 +102,8 @@ func (cll *ClusterLibraryList) Diff(cls ClusterLibraryStatuses) (ClusterLibraryL
 	}
 	for key, lib := range inState {
 		_, exists := inConfig[key]
-		if exists {
+		if exists || forAllClusters[key] {
+			// libraries for all clusters are not managed by this cluster
 			continue
 		}
 		toUninstall.Libraries = append(toUninstall.Libraries, lib)
@@ -126,9 +129,20 @@ type ClusterLibraryStatuses struct {
 }
 
 // ToLibraryList convert to envity for convenient comparison
-func (cls ClusterLibraryStatuses) ToLibraryList() ClusterLibraryList {
+func (cls ClusterLibraryStatuses) ToLibraryList(known ...Library) ClusterLibraryList {
 	cll := ClusterLibraryList{ClusterID: cls.ClusterID}
+	knownKeys := map[string]bool{}
+	for _, lib := range known {
+		_, key := lib.TypeAndKey()
+		knownKeys[key] = true
+	}
 	for _, lib := range cls.LibraryStatuses {
+		_, key := lib.Library.TypeAndKey()
+		if lib.IsLibraryInstalledOnAllClusters && !knownKeys[key] {
+			// library is installed on every cluster of the workspace,
+			// so it's part of cluster spec only when explicitly declared
+			continue
+		}
 		cll.Libraries = append(cll.Libraries, *lib.Library)
 	}
 	sort.Slice(cll.Libraries, func(i, j int) bool {
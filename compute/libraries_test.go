@@ -24,6 +24,30 @@ func TestClusterLibraryStatuses_NoNeedAllClusters(t *testing.T) {
 	assert.False(t, need)
 }
 
+func TestClusterLibraryStatuses_AllClustersNotManaged(t *testing.T) {
+	cls := ClusterLibraryStatuses{
+		ClusterID: "abc",
+		LibraryStatuses: []LibraryStatus{
+			{
+				Library: &Library{Jar: "dbfs://a.jar"},
+				Status:  "INSTALLED",
+			},
+			{
+				Library:                         &Library{Whl: "dbfs://b.whl"},
+				Status:                          "INSTALLED",
+				IsLibraryInstalledOnAllClusters: true,
+			},
+		},
+	}
+	assert.Equal(t, []Library{{Jar: "dbfs://a.jar"}}, cls.ToLibraryList().Libraries)
+	assert.Len(t, cls.ToLibraryList(Library{Whl: "dbfs://b.whl"}).Libraries, 2)
+
+	cll := ClusterLibraryList{ClusterID: "abc"}
+	toInstall, toUninstall := cll.Diff(cls)
+	assert.Len(t, toInstall.Libraries, 0)
+	assert.Equal(t, []Library{{Jar: "dbfs://a.jar"}}, toUninstall.Libraries)
+}
+
 func TestClusterLibraryStatuses_RetryingCodes(t *testing.T) {
 	need, err := ClusterLibraryStatuses{
 		ClusterID: "abc",
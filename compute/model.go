@@ -37,6 +37,30 @@ const (
 	AzureAvailabilitySpotWithFallback = "SPOT_WITH_FALLBACK_AZURE"
 )
 
+// https://docs.databricks.com/dev-tools/api/latest/clusters.html#runtimeengine
+const (
+	// RuntimeEnginePhoton runs the cluster with Photon vectorized query engine
+	RuntimeEnginePhoton = "PHOTON"
+	// RuntimeEngineStandard runs the cluster with standard Spark engine
+	RuntimeEngineStandard = "STANDARD"
+)
+
+// https://docs.databricks.com/dev-tools/api/latest/clusters.html#datasecuritymode
+const (
+	// DataSecurityModeNone has no security isolation and no access to Unity Catalog
+	DataSecurityModeNone = "NONE"
+	// DataSecurityModeSingleUser gives access to Unity Catalog for a single user, set in single_user_name
+	DataSecurityModeSingleUser = "SINGLE_USER"
+	// DataSecurityModeUserIsolation shares the cluster between users with access to Unity Catalog
+	DataSecurityModeUserIsolation = "USER_ISOLATION"
+	// DataSecurityModeLegacyTableACL is for legacy table access control clusters
+	DataSecurityModeLegacyTableACL = "LEGACY_TABLE_ACL"
+	// DataSecurityModeLegacyPassthrough is for legacy high concurrency credential passthrough clusters
+	DataSecurityModeLegacyPassthrough = "LEGACY_PASSTHROUGH"
+	// DataSecurityModeLegacySingleUser is for legacy single user credential passthrough clusters
+	DataSecurityModeLegacySingleUser = "LEGACY_SINGLE_USER"
+)
+
 // AzureDiskVolumeType is disk type on azure vms
 type AzureDiskVolumeType string
 
@@ -148,6 +172,8 @@ type AzureAttributes struct {
 type GcpAttributes struct {
 	UsePreemptibleExecutors bool   `json:"use_preemptible_executors,omitempty" tf:"computed"`
 	GoogleServiceAccount    string `json:"google_service_account,omitempty" tf:"computed"`
+	ZoneID                  string `json:"zone_id,omitempty" tf:"computed"`
+	LocalSsdCount           int32  `json:"local_ssd_count,omitempty" tf:"computed"`
 }
 
 // DbfsStorageInfo contains the destination string for DBFS
@@ -172,6 +198,21 @@ type LocalFileInfo struct {
 	Destination string `json:"destination,omitempty" tf:"optional"`
 }
 
+// AbfssStorageInfo contains the destination for Azure Data Lake Storage Gen2
+type AbfssStorageInfo struct {
+	Destination string `json:"destination"`
+}
+
+// GcsStorageInfo contains the destination for Google Cloud Storage
+type GcsStorageInfo struct {
+	Destination string `json:"destination"`
+}
+
+// WorkspaceFileInfo contains the path of file in Databricks workspace
+type WorkspaceFileInfo struct {
+	Destination string `json:"destination"`
+}
+
 // StorageInfo contains the struct for either DBFS or S3 storage depending on which one is relevant.
 type StorageInfo struct {
 	Dbfs *DbfsStorageInfo `json:"dbfs,omitempty" tf:"group:storage"`
@@ -180,9 +221,12 @@ type StorageInfo struct {
 
 // InitScriptStorageInfo captures the allowed sources of init scripts.
 type InitScriptStorageInfo struct {
-	Dbfs *DbfsStorageInfo `json:"dbfs,omitempty" tf:"group:storage"`
-	S3   *S3StorageInfo   `json:"s3,omitempty" tf:"group:storage"`
-	File *LocalFileInfo   `json:"file,omitempty" tf:"optional"`
+	Dbfs      *DbfsStorageInfo   `json:"dbfs,omitempty" tf:"group:storage"`
+	S3        *S3StorageInfo     `json:"s3,omitempty" tf:"group:storage"`
+	Abfss     *AbfssStorageInfo  `json:"abfss,omitempty" tf:"group:storage"`
+	Gcs       *GcsStorageInfo    `json:"gcs,omitempty" tf:"group:storage"`
+	Workspace *WorkspaceFileInfo `json:"workspace,omitempty" tf:"group:storage"`
+	File      *LocalFileInfo     `json:"file,omitempty" tf:"optional"`
 }
 
 // SparkNodeAwsAttributes is the struct that determines if the node is a spot instance or not
@@ -296,6 +340,8 @@ type Cluster struct {
 
 	SingleUserName   string `json:"single_user_name,omitempty"`
 	IdempotencyToken string `json:"idempotency_token,omitempty"`
+	RuntimeEngine    string `json:"runtime_engine,omitempty" tf:"computed"`
+	DataSecurityMode string `json:"data_security_mode,omitempty" tf:"computed"`
 }
 
 // ClusterList shows existing clusters
@@ -305,47 +351,49 @@ type ClusterList struct {
 
 // ClusterInfo contains the information when getting cluster info from the get request.
 type ClusterInfo struct {
-	NumWorkers                int32              `json:"num_workers,omitempty"`
-	AutoScale                 *AutoScale         `json:"autoscale,omitempty"`
-	ClusterID                 string             `json:"cluster_id,omitempty"`
-	CreatorUserName           string             `json:"creator_user_name,omitempty"`
-	Driver                    *SparkNode         `json:"driver,omitempty"`
-	Executors                 []SparkNode        `json:"executors,omitempty"`
-	SparkContextID            int64              `json:"spark_context_id,omitempty"`
-	JdbcPort                  int32              `json:"jdbc_port,omitempty"`
-	ClusterName               string             `json:"cluster_name,omitempty"`
-	SparkVersion              string             `json:"spark_version"`
-	SparkConf                 map[string]string  `json:"spark_conf,omitempty"`
-	AwsAttributes             *AwsAttributes     `json:"aws_attributes,omitempty"`
-	AzureAttributes           *AzureAttributes   `json:"azure_attributes,omitempty"`
-	GcpAttributes             *GcpAttributes     `json:"gcp_attributes,omitempty"`
-	NodeTypeID                string             `json:"node_type_id,omitempty"`
-	DriverNodeTypeID          string             `json:"driver_node_type_id,omitempty"`
-	SSHPublicKeys             []string           `json:"ssh_public_keys,omitempty"`
-	CustomTags                map[string]string  `json:"custom_tags,omitempty"`
-	ClusterLogConf            *StorageInfo       `json:"cluster_log_conf,omitempty"`
-	InitScripts               []StorageInfo      `json:"init_scripts,omitempty"`
-	SparkEnvVars              map[string]string  `json:"spark_env_vars,omitempty"`
-	AutoterminationMinutes    int32              `json:"autotermination_minutes,omitempty"`
-	EnableElasticDisk         bool               `json:"enable_elastic_disk,omitempty"`
-	EnableLocalDiskEncryption bool               `json:"enable_local_disk_encryption,omitempty"`
-	InstancePoolID            string             `json:"instance_pool_id,omitempty"`
-	DriverInstancePoolID      string             `json:"driver_instance_pool_id,omitempty" tf:"computed"`
-	PolicyID                  string             `json:"policy_id,omitempty"`
-	SingleUserName            string             `json:"single_user_name,omitempty"`
-	ClusterSource             Availability       `json:"cluster_source,omitempty"`
-	DockerImage               *DockerImage       `json:"docker_image,omitempty"`
-	State                     ClusterState       `json:"state"`
-	StateMessage              string             `json:"state_message,omitempty"`
-	StartTime                 int64              `json:"start_time,omitempty"`
-	TerminateTime             int64              `json:"terminate_time,omitempty"`
-	LastStateLossTime         int64              `json:"last_state_loss_time,omitempty"`
-	LastActivityTime          int64              `json:"last_activity_time,omitempty"`
-	ClusterMemoryMb           int64              `json:"cluster_memory_mb,omitempty"`
-	ClusterCores              float32            `json:"cluster_cores,omitempty"`
-	DefaultTags               map[string]string  `json:"default_tags"`
-	ClusterLogStatus          *LogSyncStatus     `json:"cluster_log_status,omitempty"`
-	TerminationReason         *TerminationReason `json:"termination_reason,omitempty"`
+	NumWorkers                int32                   `json:"num_workers,omitempty"`
+	AutoScale                 *AutoScale              `json:"autoscale,omitempty"`
+	ClusterID                 string                  `json:"cluster_id,omitempty"`
+	CreatorUserName           string                  `json:"creator_user_name,omitempty"`
+	Driver                    *SparkNode              `json:"driver,omitempty"`
+	Executors                 []SparkNode             `json:"executors,omitempty"`
+	SparkContextID            int64                   `json:"spark_context_id,omitempty"`
+	JdbcPort                  int32                   `json:"jdbc_port,omitempty"`
+	ClusterName               string                  `json:"cluster_name,omitempty"`
+	SparkVersion              string                  `json:"spark_version"`
+	SparkConf                 map[string]string       `json:"spark_conf,omitempty"`
+	AwsAttributes             *AwsAttributes          `json:"aws_attributes,omitempty"`
+	AzureAttributes           *AzureAttributes        `json:"azure_attributes,omitempty"`
+	GcpAttributes             *GcpAttributes          `json:"gcp_attributes,omitempty"`
+	NodeTypeID                string                  `json:"node_type_id,omitempty"`
+	DriverNodeTypeID          string                  `json:"driver_node_type_id,omitempty"`
+	SSHPublicKeys             []string                `json:"ssh_public_keys,omitempty"`
+	CustomTags                map[string]string       `json:"custom_tags,omitempty"`
+	ClusterLogConf            *StorageInfo            `json:"cluster_log_conf,omitempty"`
+	InitScripts               []InitScriptStorageInfo `json:"init_scripts,omitempty"`
+	SparkEnvVars              map[string]string       `json:"spark_env_vars,omitempty"`
+	AutoterminationMinutes    int32                   `json:"autotermination_minutes,omitempty"`
+	EnableElasticDisk         bool                    `json:"enable_elastic_disk,omitempty"`
+	EnableLocalDiskEncryption bool                    `json:"enable_local_disk_encryption,omitempty"`
+	InstancePoolID            string                  `json:"instance_pool_id,omitempty"`
+	DriverInstancePoolID      string                  `json:"driver_instance_pool_id,omitempty" tf:"computed"`
+	PolicyID                  string                  `json:"policy_id,omitempty"`
+	SingleUserName            string                  `json:"single_user_name,omitempty"`
+	RuntimeEngine             string                  `json:"runtime_engine,omitempty"`
+	DataSecurityMode          string                  `json:"data_security_mode,omitempty"`
+	ClusterSource             Availability            `json:"cluster_source,omitempty"`
+	DockerImage               *DockerImage            `json:"docker_image,omitempty"`
+	State                     ClusterState            `json:"state"`
+	StateMessage              string                  `json:"state_message,omitempty"`
+	StartTime                 int64                   `json:"start_time,omitempty"`
+	TerminateTime             int64                   `json:"terminate_time,omitempty"`
+	LastStateLossTime         int64                   `json:"last_state_loss_time,omitempty"`
+	LastActivityTime          int64                   `json:"last_activity_time,omitempty"`
+	ClusterMemoryMb           int64                   `json:"cluster_memory_mb,omitempty"`
+	ClusterCores              float32                 `json:"cluster_cores,omitempty"`
+	DefaultTags               map[string]string       `json:"default_tags"`
+	ClusterLogStatus          *LogSyncStatus          `json:"cluster_log_status,omitempty"`
+	TerminationReason         *TerminationReason      `json:"termination_reason,omitempty"`
 }
 
 // IsRunningOrResizing returns true if cluster is running or resizing
@@ -358,6 +406,13 @@ type ClusterID struct {
 	ClusterID string `json:"cluster_id,omitempty" url:"cluster_id,omitempty"`
 }
 
+// ResizeRequest changes number of workers of a running cluster without restarting it
+type ResizeRequest struct {
+	ClusterID  string     `json:"cluster_id"`
+	NumWorkers int32      `json:"num_workers,omitempty"`
+	Autoscale  *AutoScale `json:"autoscale,omitempty"`
+}
+
 // ClusterPolicy defines cluster policy
 type ClusterPolicy struct {
 	PolicyID           string `json:"policy_id,omitempty"`
@@ -366,6 +421,12 @@ type ClusterPolicy struct {
 	CreatedAtTimeStamp int64  `json:"created_at_timestamp"`
 }
 
+// ClusterPolicyList contains all cluster policies of the workspace
+type ClusterPolicyList struct {
+	Policies   []ClusterPolicy `json:"policies,omitempty"`
+	TotalCount int32           `json:"total_count,omitempty"`
+}
+
 // ClusterPolicyCreate is the endity used for request
 type ClusterPolicyCreate struct {
 	Name       string `json:"name"`
@@ -393,6 +454,12 @@ type InstancePoolAzureAttributes struct {
 	SpotBidMaxPrice float64      `json:"spot_bid_max_price,omitempty"`
 }
 
+// InstancePoolGcpAttributes contains attributes for GCP Databricks deployments for instance pools
+type InstancePoolGcpAttributes struct {
+	ZoneID        string `json:"zone_id,omitempty" tf:"computed"`
+	LocalSsdCount int32  `json:"local_ssd_count,omitempty" tf:"computed"`
+}
+
 // InstancePoolDiskType contains disk type information for each of the different cloud service providers
 type InstancePoolDiskType struct {
 	AzureDiskVolumeType string `json:"azure_disk_volume_type,omitempty"`
@@ -415,6 +482,7 @@ type InstancePool struct {
 	IdleInstanceAutoTerminationMinutes int32                        `json:"idle_instance_autotermination_minutes"`
 	AwsAttributes                      *InstancePoolAwsAttributes   `json:"aws_attributes,omitempty"`
 	AzureAttributes                    *InstancePoolAzureAttributes `json:"azure_attributes,omitempty"`
+	GcpAttributes                      *InstancePoolGcpAttributes   `json:"gcp_attributes,omitempty"`
 	NodeTypeID                         string                       `json:"node_type_id"`
 	CustomTags                         map[string]string            `json:"custom_tags,omitempty"`
 	EnableElasticDisk                  bool                         `json:"enable_elastic_disk,omitempty"`
@@ -439,6 +507,7 @@ type InstancePoolAndStats struct {
 	MaxCapacity                        int32                        `json:"max_capacity,omitempty"`
 	AwsAttributes                      *InstancePoolAwsAttributes   `json:"aws_attributes,omitempty"`
 	AzureAttributes                    *InstancePoolAzureAttributes `json:"azure_attributes,omitempty"`
+	GcpAttributes                      *InstancePoolGcpAttributes   `json:"gcp_attributes,omitempty"`
 	NodeTypeID                         string                       `json:"node_type_id"`
 	DefaultTags                        map[string]string            `json:"default_tags,omitempty" tf:"computed"`
 	CustomTags                         map[string]string            `json:"custom_tags,omitempty"`
@@ -517,12 +586,95 @@ type SparkSubmitTask struct {
 	Parameters []string `json:"parameters,omitempty"`
 }
 
+// DbtTask contains the information for dbt tasks of multi-task jobs
+type DbtTask struct {
+	Commands         []string `json:"commands"`
+	ProjectDirectory string   `json:"project_directory,omitempty"`
+	Schema           string   `json:"schema,omitempty"`
+	WarehouseID      string   `json:"warehouse_id,omitempty"`
+}
+
+// PipelineTask contains the information for Delta Live Tables pipeline tasks of multi-task jobs
+type PipelineTask struct {
+	PipelineID  string `json:"pipeline_id"`
+	FullRefresh bool   `json:"full_refresh,omitempty"`
+}
+
+// SQLQueryTask refers to the SQL query to run
+type SQLQueryTask struct {
+	QueryID string `json:"query_id"`
+}
+
+// SQLDashboardTask refers to the SQL dashboard to refresh
+type SQLDashboardTask struct {
+	DashboardID string `json:"dashboard_id"`
+}
+
+// SQLAlertTask refers to the SQL alert to evaluate
+type SQLAlertTask struct {
+	AlertID string `json:"alert_id"`
+}
+
+// SQLFileTask refers to the file with SQL statements to run
+type SQLFileTask struct {
+	Path string `json:"path"`
+}
+
+// SQLTask contains the information for SQL tasks of multi-task jobs, that run on SQL warehouses
+type SQLTask struct {
+	Query       *SQLQueryTask     `json:"query,omitempty"`
+	Dashboard   *SQLDashboardTask `json:"dashboard,omitempty"`
+	Alert       *SQLAlertTask     `json:"alert,omitempty"`
+	File        *SQLFileTask      `json:"file,omitempty"`
+	WarehouseID string            `json:"warehouse_id"`
+	Parameters  map[string]string `json:"parameters,omitempty"`
+}
+
 // JobEmailNotifications contains the information for email notifications after job completion
 type JobEmailNotifications struct {
-	OnStart               []string `json:"on_start,omitempty"`
-	OnSuccess             []string `json:"on_success,omitempty"`
-	OnFailure             []string `json:"on_failure,omitempty"`
-	NoAlertForSkippedRuns bool     `json:"no_alert_for_skipped_runs,omitempty"`
+	OnStart                            []string `json:"on_start,omitempty"`
+	OnSuccess                          []string `json:"on_success,omitempty"`
+	OnFailure                          []string `json:"on_failure,omitempty"`
+	OnDurationWarningThresholdExceeded []string `json:"on_duration_warning_threshold_exceeded,omitempty"`
+	NoAlertForSkippedRuns              bool     `json:"no_alert_for_skipped_runs,omitempty"`
+}
+
+// JobHealthRule defines the threshold of a metric, above which the job or task is unhealthy
+type JobHealthRule struct {
+	Metric string `json:"metric"`
+	Op     string `json:"op"`
+	Value  int64  `json:"value"`
+}
+
+// JobHealth contains the rules, that trigger `on_duration_warning_threshold_exceeded` notifications
+type JobHealth struct {
+	Rules []JobHealthRule `json:"rules"`
+}
+
+// Webhook refers to the notification destination by its ID
+type Webhook struct {
+	ID string `json:"id"`
+}
+
+// WebhookNotifications contains notification destinations, that are called on run events
+type WebhookNotifications struct {
+	OnStart                            []Webhook `json:"on_start,omitempty"`
+	OnSuccess                          []Webhook `json:"on_success,omitempty"`
+	OnFailure                          []Webhook `json:"on_failure,omitempty"`
+	OnDurationWarningThresholdExceeded []Webhook `json:"on_duration_warning_threshold_exceeded,omitempty"`
+}
+
+// JobNotificationSettings control, which outcomes of job runs are notified about
+type JobNotificationSettings struct {
+	NoAlertForSkippedRuns  bool `json:"no_alert_for_skipped_runs,omitempty"`
+	NoAlertForCanceledRuns bool `json:"no_alert_for_canceled_runs,omitempty"`
+}
+
+// TaskNotificationSettings control, which outcomes of task runs are notified about
+type TaskNotificationSettings struct {
+	NoAlertForSkippedRuns  bool `json:"no_alert_for_skipped_runs,omitempty"`
+	NoAlertForCanceledRuns bool `json:"no_alert_for_canceled_runs,omitempty"`
+	AlertOnLastAttempt     bool `json:"alert_on_last_attempt,omitempty"`
 }
 
 // CronSchedule contains the information for the quartz cron expression
@@ -532,6 +684,111 @@ type CronSchedule struct {
 	PauseStatus          string `json:"pause_status,omitempty" tf:"computed"`
 }
 
+// JobFormatMultiTask is the format of jobs, that have an array of tasks
+const JobFormatMultiTask = "MULTI_TASK"
+
+// TaskDependency refers to another task of the same multi-task job, that has to complete first
+type TaskDependency struct {
+	TaskKey string `json:"task_key,omitempty"`
+}
+
+// JobTaskSettings contains the information for configuring a single task of a multi-task job
+type JobTaskSettings struct {
+	TaskKey     string           `json:"task_key"`
+	Description string           `json:"description,omitempty"`
+	DependsOn   []TaskDependency `json:"depends_on,omitempty"`
+
+	ExistingClusterID string   `json:"existing_cluster_id,omitempty" tf:"group:cluster_type"`
+	NewCluster        *Cluster `json:"new_cluster,omitempty" tf:"group:cluster_type"`
+	JobClusterKey     string   `json:"job_cluster_key,omitempty" tf:"group:cluster_type"`
+	// EnvironmentKey refers to serverless environment of the job, where python tasks are run
+	EnvironmentKey string `json:"environment_key,omitempty" tf:"group:cluster_type"`
+
+	NotebookTask    *NotebookTask    `json:"notebook_task,omitempty" tf:"group:task_type"`
+	SparkJarTask    *SparkJarTask    `json:"spark_jar_task,omitempty" tf:"group:task_type"`
+	SparkPythonTask *SparkPythonTask `json:"spark_python_task,omitempty" tf:"group:task_type"`
+	SparkSubmitTask *SparkSubmitTask `json:"spark_submit_task,omitempty" tf:"group:task_type"`
+	DbtTask         *DbtTask         `json:"dbt_task,omitempty" tf:"group:task_type"`
+	PipelineTask    *PipelineTask    `json:"pipeline_task,omitempty" tf:"group:task_type"`
+	SQLTask         *SQLTask         `json:"sql_task,omitempty" tf:"group:task_type"`
+
+	Libraries              []Library `json:"libraries,omitempty" tf:"slice_set,alias:library"`
+	TimeoutSeconds         int32     `json:"timeout_seconds,omitempty"`
+	MaxRetries             int32     `json:"max_retries,omitempty"`
+	MinRetryIntervalMillis int32     `json:"min_retry_interval_millis,omitempty"`
+	RetryOnTimeout         bool      `json:"retry_on_timeout,omitempty"`
+
+	Health               *JobHealth                `json:"health,omitempty"`
+	EmailNotifications   *JobEmailNotifications    `json:"email_notifications,omitempty"`
+	WebhookNotifications *WebhookNotifications     `json:"webhook_notifications,omitempty"`
+	NotificationSettings *TaskNotificationSettings `json:"notification_settings,omitempty"`
+}
+
+// ContinuousConf contains the information for jobs, that always have an active run
+type ContinuousConf struct {
+	PauseStatus string `json:"pause_status,omitempty" tf:"default:UNPAUSED"`
+}
+
+// FileArrivalTrigger starts a run, when new files arrive to the external location
+type FileArrivalTrigger struct {
+	URL                           string `json:"url"`
+	MinTimeBetweenTriggersSeconds int32  `json:"min_time_between_triggers_seconds,omitempty"`
+	WaitAfterLastChangeSeconds    int32  `json:"wait_after_last_change_seconds,omitempty"`
+}
+
+// TableUpdateTrigger starts a run, when any or all of the tables are updated
+type TableUpdateTrigger struct {
+	TableNames                    []string `json:"table_names"`
+	Condition                     string   `json:"condition,omitempty" tf:"default:ANY_UPDATED"`
+	MinTimeBetweenTriggersSeconds int32    `json:"min_time_between_triggers_seconds,omitempty"`
+	WaitAfterLastChangeSeconds    int32    `json:"wait_after_last_change_seconds,omitempty"`
+}
+
+// Trigger contains the information for event-driven runs of a job
+type Trigger struct {
+	FileArrival *FileArrivalTrigger `json:"file_arrival,omitempty"`
+	TableUpdate *TableUpdateTrigger `json:"table_update,omitempty"`
+	PauseStatus string              `json:"pause_status,omitempty" tf:"default:UNPAUSED"`
+}
+
+// QueueSettings contains the information for queueing runs of a job, that hit concurrency limits
+type QueueSettings struct {
+	Enabled bool `json:"enabled"`
+}
+
+// JobCluster is a named cluster definition, that is shared by tasks of a multi-task job
+type JobCluster struct {
+	JobClusterKey string   `json:"job_cluster_key"`
+	NewCluster    *Cluster `json:"new_cluster"`
+}
+
+// EnvironmentSpec contains the client version and pip dependencies of serverless environment
+type EnvironmentSpec struct {
+	Client       string   `json:"client"`
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+// JobEnvironment is a named serverless environment, that is shared by tasks of a multi-task job
+type JobEnvironment struct {
+	EnvironmentKey string           `json:"environment_key"`
+	Spec           *EnvironmentSpec `json:"spec"`
+}
+
+// JobDeployment describes the tool, that manages the job, like Databricks Asset Bundles
+type JobDeployment struct {
+	Kind             string `json:"kind"`
+	MetadataFilePath string `json:"metadata_file_path,omitempty"`
+}
+
+// GitSource contains the Git repository, from which notebook tasks of a job are run
+type GitSource struct {
+	URL      string `json:"git_url" tf:"alias:url"`
+	Provider string `json:"git_provider,omitempty" tf:"alias:provider,computed"`
+	Branch   string `json:"git_branch,omitempty" tf:"alias:branch"`
+	Tag      string `json:"git_tag,omitempty" tf:"alias:tag"`
+	Commit   string `json:"git_commit,omitempty" tf:"alias:commit"`
+}
+
 // JobSettings contains the information for configuring a job on databricks
 type JobSettings struct {
 	Name string `json:"name,omitempty" tf:"default:Untitled"`
@@ -544,20 +801,48 @@ type JobSettings struct {
 	SparkPythonTask *SparkPythonTask `json:"spark_python_task,omitempty" tf:"group:task_type"`
 	SparkSubmitTask *SparkSubmitTask `json:"spark_submit_task,omitempty" tf:"group:task_type"`
 
-	Libraries              []Library     `json:"libraries,omitempty" tf:"slice_set,alias:library"`
-	TimeoutSeconds         int32         `json:"timeout_seconds,omitempty"`
-	MaxRetries             int32         `json:"max_retries,omitempty"`
-	MinRetryIntervalMillis int32         `json:"min_retry_interval_millis,omitempty"`
-	RetryOnTimeout         bool          `json:"retry_on_timeout,omitempty"`
-	Schedule               *CronSchedule `json:"schedule,omitempty"`
-	MaxConcurrentRuns      int32         `json:"max_concurrent_runs,omitempty"`
+	// Tasks are only supported by Jobs API 2.1 and make the job MULTI_TASK
+	Tasks       []JobTaskSettings `json:"tasks,omitempty" tf:"alias:task"`
+	JobClusters []JobCluster      `json:"job_clusters,omitempty" tf:"alias:job_cluster"`
+	// Environments are used by python tasks running on serverless compute
+	Environments []JobEnvironment `json:"environments,omitempty" tf:"alias:environment"`
+	Format       string           `json:"format,omitempty" tf:"computed"`
+	GitSource    *GitSource       `json:"git_source,omitempty"`
+
+	// Tags of the job itself, that are not propagated to its clusters
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// EditMode set to UI_LOCKED prevents changes of the job through the UI
+	EditMode   string         `json:"edit_mode,omitempty" tf:"computed"`
+	Deployment *JobDeployment `json:"deployment,omitempty"`
+
+	Libraries              []Library       `json:"libraries,omitempty" tf:"slice_set,alias:library"`
+	TimeoutSeconds         int32           `json:"timeout_seconds,omitempty"`
+	MaxRetries             int32           `json:"max_retries,omitempty"`
+	MinRetryIntervalMillis int32           `json:"min_retry_interval_millis,omitempty"`
+	RetryOnTimeout         bool            `json:"retry_on_timeout,omitempty"`
+	Schedule               *CronSchedule   `json:"schedule,omitempty"`
+	Continuous             *ContinuousConf `json:"continuous,omitempty"`
+	Trigger                *Trigger        `json:"trigger,omitempty"`
+	MaxConcurrentRuns      int32           `json:"max_concurrent_runs,omitempty"`
+	Queue                  *QueueSettings  `json:"queue,omitempty"`
+
+	Health               *JobHealth               `json:"health,omitempty"`
+	EmailNotifications   *JobEmailNotifications   `json:"email_notifications,omitempty"`
+	WebhookNotifications *WebhookNotifications    `json:"webhook_notifications,omitempty"`
+	NotificationSettings *JobNotificationSettings `json:"notification_settings,omitempty"`
+}
 
-	EmailNotifications *JobEmailNotifications `json:"email_notifications,omitempty"`
+// JobListRequest is used to page through jobs of the workspace
+type JobListRequest struct {
+	Offset int32 `url:"offset,omitempty"`
+	Limit  int32 `url:"limit,omitempty"`
 }
 
 // JobList ...
 type JobList struct {
-	Jobs []Job `json:"jobs"`
+	Jobs    []Job `json:"jobs"`
+	HasMore bool  `json:"has_more,omitempty"`
 }
 
 // Job contains the information when using a GET request from the Databricks Jobs api
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -20,6 +21,14 @@ const DefaultProvisionTimeout = 30 * time.Minute
 
 var clusterSchema = resourceClusterSchema()
 
+// newIdempotencyToken generates token, that is sent with cluster creation request, when
+// it's not configured explicitly. Every resource gets its own token, so that retries of
+// timed out creation request don't launch duplicate clusters, and clusters with the same
+// definition never share the same token.
+var newIdempotencyToken = func() string {
+	return resource.PrefixedUniqueId("tf-")
+}
+
 // ResourceCluster - returns Cluster resource description
 func ResourceCluster() *schema.Resource {
 	return common.Resource{
@@ -30,6 +39,7 @@ func ResourceCluster() *schema.Resource {
 			d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewClustersAPI(ctx, c).PermanentDelete(d.Id())
 		},
+		CustomizeDiff: resourceClusterDiff,
 		Schema:        clusterSchema,
 		SchemaVersion: 2,
 		Timeouts: &schema.ResourceTimeout{
@@ -40,16 +50,6 @@ func ResourceCluster() *schema.Resource {
 	}.ToResource()
 }
 
-func sparkConfDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
-	isPossiblyLegacyConfig := k == "spark_conf.%" && old == "1" && new == "0"
-	isLegacyConfig := k == "spark_conf.spark.databricks.delta.preview.enabled"
-	if isPossiblyLegacyConfig || isLegacyConfig {
-		log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
-		return true
-	}
-	return false
-}
-
 func resourceClusterSchema() map[string]*schema.Schema {
 	return common.StructToSchema(Cluster{}, func(s map[string]*schema.Schema) map[string]*schema.Schema {
 		s["spark_conf"].DiffSuppressFunc = sparkConfDiffSuppressFunc
@@ -63,8 +63,28 @@ func resourceClusterSchema() map[string]*schema.Schema {
 		if err == nil {
 			p.Sensitive = true
 		}
+		if p, err := common.SchemaPath(s, "cluster_log_conf", "s3", "encryption_type"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"sse-s3", "sse-kms"}, false)
+		}
+		if p, err := common.SchemaPath(s, "azure_attributes", "availability"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{
+				AzureAvailabilitySpot,
+				AzureAvailabilityOnDemand,
+				AzureAvailabilitySpotWithFallback,
+			}, false)
+		}
+		if p, err := common.SchemaPath(s, "aws_attributes", "zone_id"); err == nil {
+			p.ValidateFunc = validateAwsZoneID
+			p.DiffSuppressFunc = awsZoneAutoSuppressFunc
+		}
+		s["runtime_engine"].ValidateFunc = validation.StringInSlice([]string{
+			RuntimeEnginePhoton,
+			RuntimeEngineStandard,
+		}, false)
+		s["data_security_mode"].ValidateFunc = validation.StringInSlice(dataSecurityModes, false)
 		s["autotermination_minutes"].Default = 60
 		s["idempotency_token"].ForceNew = true
+		s["idempotency_token"].Computed = true
 		s["cluster_id"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Optional: true,
@@ -111,6 +131,16 @@ func resourceClusterSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		}
+		s["always_running"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
+		s["no_wait"] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		}
 		return s
 	})
 }
@@ -128,6 +158,120 @@ func validateClusterDefinition(cluster Cluster) error {
 	return fmt.Errorf("NumWorkers could be 0 only for SingleNode clusters. See https://docs.databricks.com/clusters/single-node.html for more details")
 }
 
+func resourceClusterDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("always_running").(bool) {
+		if d.Get("autotermination_minutes").(int) != 0 {
+			return fmt.Errorf("`always_running` must be specified only with `autotermination_minutes = 0`")
+		}
+		if d.Id() != "" && d.Get("state").(string) == string(ClusterStateTerminated) {
+			// terminated cluster has to be started during apply
+			if err := d.SetNewComputed("state"); err != nil {
+				return err
+			}
+		}
+	}
+	if err := validateAwsAttributesWithPool(d); err != nil {
+		return err
+	}
+	if d.NewValueKnown("data_security_mode") && d.NewValueKnown("single_user_name") {
+		err := validateDataSecurityMode(d.Get("data_security_mode").(string),
+			d.Get("single_user_name").(string))
+		if err != nil {
+			return err
+		}
+	}
+	return validateClusterPolicyDiff(ctx, d, m)
+}
+
+// awsAttributesFromPool can be configured only on the instance pool, as API rejects
+// them for clusters with `instance_pool_id` with a cryptic error
+var awsAttributesFromPool = []string{
+	"availability",
+	"zone_id",
+	"first_on_demand",
+	"spot_bid_price_percent",
+	"ebs_volume_type",
+	"ebs_volume_count",
+	"ebs_volume_size",
+}
+
+// validateAwsAttributesWithPool checks only new clusters, because
+// state of existing ones contains attributes received from the API
+func validateAwsAttributesWithPool(d *schema.ResourceDiff) error {
+	if d.Id() != "" {
+		return nil
+	}
+	if d.Get("instance_pool_id").(string) == "" && d.NewValueKnown("instance_pool_id") {
+		return nil
+	}
+	for _, attr := range awsAttributesFromPool {
+		k := "aws_attributes.0." + attr
+		if !d.NewValueKnown(k) {
+			continue
+		}
+		if v, ok := d.GetOk(k); ok && v != "" {
+			return fmt.Errorf("`%s` cannot be used together with `instance_pool_id`, "+
+				"as it's defined by the instance pool", k)
+		}
+	}
+	return nil
+}
+
+var dataSecurityModes = []string{
+	DataSecurityModeNone,
+	DataSecurityModeSingleUser,
+	DataSecurityModeUserIsolation,
+	DataSecurityModeLegacyTableACL,
+	DataSecurityModeLegacyPassthrough,
+	DataSecurityModeLegacySingleUser,
+}
+
+// validateDataSecurityMode checks combination of access mode and assigned user
+func validateDataSecurityMode(mode, singleUserName string) error {
+	switch mode {
+	case DataSecurityModeSingleUser, DataSecurityModeLegacySingleUser:
+		if singleUserName == "" {
+			return fmt.Errorf("`single_user_name` is required for `data_security_mode = \"%s\"`", mode)
+		}
+	case DataSecurityModeUserIsolation, DataSecurityModeLegacyTableACL, DataSecurityModeLegacyPassthrough:
+		if singleUserName != "" {
+			return fmt.Errorf("`single_user_name` cannot be used with shared `data_security_mode = \"%s\"`", mode)
+		}
+	}
+	return nil
+}
+
+// AwsZoneAuto lets Databricks pick availability zone based on available IPs and capacity
+const AwsZoneAuto = "auto"
+
+var validateAwsZoneID = validation.StringMatch(regexp.MustCompile(`^(auto|[a-z0-9-]+)$`),
+	"should be `auto` or lower-case availability zone, like `us-east-1a`")
+
+// awsZoneAutoSuppressFunc ignores availability zone, that was picked for `zone_id = "auto"`
+func awsZoneAutoSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return new == AwsZoneAuto && old != ""
+}
+
+// validateClusterPolicyDiff fetches the policy referenced by `policy_id` and checks
+// configured attributes against it during plan
+func validateClusterPolicyDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	policyID := d.Get("policy_id").(string)
+	if policyID == "" || !d.NewValueKnown("policy_id") || len(d.GetChangedKeysPrefix("")) == 0 {
+		return nil
+	}
+	var cluster Cluster
+	if err := common.DiffToStructPointer(d, clusterSchema, &cluster); err != nil {
+		return err
+	}
+	policy, err := NewClusterPoliciesAPI(ctx, m).Get(policyID)
+	if err != nil {
+		// workspace may not exist yet, so the policy is verified by the API during apply
+		log.Printf("[WARN] Cannot verify cluster against policy %s: %s", policyID, err)
+		return nil
+	}
+	return policy.ValidateCluster(cluster)
+}
+
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 	var cluster Cluster
 	clusters := NewClustersAPI(ctx, c)
@@ -138,8 +282,18 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 	if err = validateClusterDefinition(cluster); err != nil {
 		return err
 	}
+	if cluster.IdempotencyToken == "" {
+		cluster.IdempotencyToken = newIdempotencyToken()
+		d.Set("idempotency_token", cluster.IdempotencyToken)
+	}
 	modifyClusterRequest(&cluster)
-	clusterInfo, err := clusters.Create(cluster)
+	var clusterInfo ClusterInfo
+	noWait := d.Get("no_wait").(bool)
+	if noWait {
+		clusterInfo, err = clusters.CreateNoWait(cluster)
+	} else {
+		clusterInfo, err = clusters.Create(cluster)
+	}
 	if err != nil {
 		return err
 	}
@@ -161,6 +315,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, c *commo
 		if err = librariesAPI.Install(libraryList); err != nil {
 			return err
 		}
+		if noWait {
+			// libraries are installed by the platform, once the cluster starts
+			return nil
+		}
 		if _, err := waitForLibrariesInstalled(librariesAPI, clusterInfo); err != nil {
 			return err
 		}
@@ -192,9 +350,19 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	if err != nil {
 		return err
 	}
+	clusterInfo.CustomTags = c.WithoutDefaultTags(clusterInfo.CustomTags, d.Get("custom_tags"))
+	clusterInfo.CustomTags = withoutServerManaged(clusterInfo.CustomTags, d.Get("custom_tags"), serverManagedTags)
+	clusterInfo.SparkConf = withoutServerManaged(clusterInfo.SparkConf, d.Get("spark_conf"), serverManagedSparkConf)
 	if err = common.StructToData(clusterInfo, clusterSchema, d); err != nil {
 		return err
 	}
+	// explicitly persist defaults of provider-only attributes, so that
+	// imported clusters have no changes on the next plan
+	for _, k := range []string{"always_running", "no_wait"} {
+		if err = d.Set(k, d.Get(k)); err != nil {
+			return err
+		}
+	}
 	if err = setPinnedStatus(d, clusterAPI); err != nil {
 		return err
 	}
@@ -204,13 +372,17 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, c *common.
 	if err != nil {
 		return err
 	}
-	libList := libsClusterStatus.ToLibraryList()
+	var declared ClusterLibraryList
+	if err = common.DataToStructPointer(d, clusterSchema, &declared); err != nil {
+		return err
+	}
+	libList := libsClusterStatus.ToLibraryList(declared.Libraries...)
 	return common.StructToData(libList, clusterSchema, d)
 }
 
 func waitForLibrariesInstalled(
 	libraries LibrariesAPI, clusterInfo ClusterInfo) (result *ClusterLibraryStatuses, err error) {
-	err = resource.RetryContext(libraries.context, 30*time.Minute, func() *resource.RetryError {
+	err = libraries.client.RetryContext(libraries.context, 30*time.Minute, func() *resource.RetryError {
 		libsClusterStatus, err := libraries.ClusterStatus(clusterInfo.ClusterID)
 		if ae, ok := err.(common.APIError); ok && ae.IsMissing() {
 			// eventual consistency error
@@ -238,17 +410,31 @@ func waitForLibrariesInstalled(
 	return
 }
 
-func hasClusterConfigChanged(d *schema.ResourceData) bool {
+func changedClusterConfigKeys(d *schema.ResourceData) (changed []string) {
 	for k := range clusterSchema {
 		// TODO: create a map if we'll add more non-cluster config parameters in the future
-		if k == "library" || k == "is_pinned" {
+		if k == "library" || k == "is_pinned" || k == "always_running" || k == "state" || k == "no_wait" {
 			continue
 		}
 		if d.HasChange(k) {
-			return true
+			changed = append(changed, k)
+		}
+	}
+	return
+}
+
+// isClusterResizeOnly tells if changed keys could be applied via resize API,
+// that doesn't restart the cluster
+func isClusterResizeOnly(changed []string) bool {
+	if len(changed) == 0 {
+		return false
+	}
+	for _, k := range changed {
+		if k != "num_workers" && k != "autoscale" {
+			return false
 		}
 	}
-	return false
+	return true
 }
 
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -260,14 +446,30 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 		return err
 	}
 	var clusterInfo ClusterInfo
-	if hasClusterConfigChanged(d) {
+	changed := changedClusterConfigKeys(d)
+	if len(changed) > 0 {
 		log.Printf("[DEBUG] Cluster state has changed!")
 		err = validateClusterDefinition(cluster)
 		if err != nil {
 			return err
 		}
-		modifyClusterRequest(&cluster)
-		clusterInfo, err = clusters.Edit(cluster)
+		if isClusterResizeOnly(changed) {
+			clusterInfo, err = clusters.Get(clusterID)
+			if err != nil {
+				return err
+			}
+		}
+		if clusterInfo.State == ClusterStateRunning {
+			log.Printf("[INFO] Resizing %s without restart", clusterID)
+			clusterInfo, err = clusters.Resize(ResizeRequest{
+				ClusterID:  clusterID,
+				NumWorkers: cluster.NumWorkers,
+				Autoscale:  cluster.Autoscale,
+			})
+		} else {
+			modifyClusterRequest(&cluster)
+			clusterInfo, err = clusters.Edit(cluster)
+		}
 		if err != nil {
 			return err
 		}
@@ -277,6 +479,13 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, c *commo
 			return err
 		}
 	}
+	if d.Get("always_running").(bool) && !clusterInfo.IsRunningOrResizing() {
+		log.Printf("[INFO] %s is declared as always running, so starting it", clusterID)
+		clusterInfo, err = clusters.StartAndGetInfo(clusterID)
+		if err != nil {
+			return err
+		}
+	}
 	oldPinned, newPinned := d.GetChange("is_pinned")
 	if oldPinned.(bool) != newPinned.(bool) {
 		log.Printf("[DEBUG] Update: is_pinned. Old: %v, New: %v", oldPinned, newPinned)
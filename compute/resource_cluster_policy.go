@@ -2,6 +2,10 @@ package compute
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -50,11 +54,97 @@ func (a ClusterPoliciesAPI) Get(policyID string) (policy ClusterPolicy, err erro
 	return
 }
 
+// List returns all cluster policies
+func (a ClusterPoliciesAPI) List() ([]ClusterPolicy, error) {
+	var policyList ClusterPolicyList
+	err := a.client.Get(a.context, "/policies/clusters/list", nil, &policyList)
+	return policyList.Policies, err
+}
+
 // Delete removes cluster policy
 func (a ClusterPoliciesAPI) Delete(policyID string) error {
 	return a.client.Post(a.context, "/policies/clusters/delete", policyIDWrapper{policyID}, nil)
 }
 
+// policyElement is a single attribute rule of cluster policy definition
+type policyElement struct {
+	Type     string      `json:"type"`
+	Value    interface{} `json:"value,omitempty"`
+	MinValue *float64    `json:"minValue,omitempty"`
+	MaxValue *float64    `json:"maxValue,omitempty"`
+}
+
+// ValidateCluster checks cluster attributes against fixed and range rules of the policy,
+// so that violations are reported during plan instead of opaque errors during apply
+func (policy ClusterPolicy) ValidateCluster(cluster Cluster) error {
+	var definition map[string]policyElement
+	if err := json.Unmarshal([]byte(policy.Definition), &definition); err != nil {
+		return fmt.Errorf("cannot parse definition of cluster policy %s: %w", policy.PolicyID, err)
+	}
+	raw, err := json.Marshal(cluster)
+	if err != nil {
+		return err
+	}
+	var attributes map[string]interface{}
+	if err = json.Unmarshal(raw, &attributes); err != nil {
+		return err
+	}
+	violations := []string{}
+	for path, element := range definition {
+		value, ok := policyAttributeValue(attributes, path)
+		if !ok {
+			// attributes, that are not configured, are filled in by policy
+			continue
+		}
+		switch element.Type {
+		case "fixed":
+			if fmt.Sprint(value) != fmt.Sprint(element.Value) {
+				violations = append(violations, fmt.Sprintf("%s must be %v, but is %v",
+					path, element.Value, value))
+			}
+		case "range":
+			number, isNumber := value.(float64)
+			if !isNumber {
+				continue
+			}
+			if element.MinValue != nil && number < *element.MinValue {
+				violations = append(violations, fmt.Sprintf("%s must be at least %v, but is %v",
+					path, *element.MinValue, number))
+			}
+			if element.MaxValue != nil && number > *element.MaxValue {
+				violations = append(violations, fmt.Sprintf("%s must be at most %v, but is %v",
+					path, *element.MaxValue, number))
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.Strings(violations)
+	return fmt.Errorf("cluster does not conform to policy %s: %s",
+		policy.PolicyID, strings.Join(violations, ", "))
+}
+
+// policyAttributeValue resolves policy path, like `autoscale.max_workers` or
+// `spark_conf.spark.databricks.cluster.profile`, where map keys may contain dots
+func policyAttributeValue(attributes map[string]interface{}, path string) (interface{}, bool) {
+	parts := strings.Split(path, ".")
+	var current interface{} = attributes
+	for i := 0; i < len(parts); i++ {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok := m[strings.Join(parts[i:], ".")]; ok {
+			return v, true
+		}
+		if current, ok = m[parts[i]]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
 func parsePolicyFromData(d *schema.ResourceData) (*ClusterPolicy, error) {
 	clusterPolicy := new(ClusterPolicy)
 	clusterPolicy.PolicyID = d.Id()
@@ -236,3 +236,38 @@ func TestResourceClusterPolicyDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc", d.Id())
 }
+
+func TestClusterPolicyValidateCluster(t *testing.T) {
+	policy := ClusterPolicy{
+		PolicyID: "abc",
+		Definition: `{
+			"num_workers": {"type": "range", "minValue": 2, "maxValue": 8},
+			"enable_elastic_disk": {"type": "fixed", "value": true},
+			"custom_tags.Team": {"type": "fixed", "value": "data"},
+			"node_type_id": {"type": "allowlist", "values": ["i3.xlarge"]},
+			"dbus_per_hour": {"type": "range", "maxValue": 10}
+		}`,
+	}
+	err := policy.ValidateCluster(Cluster{
+		NumWorkers:        4,
+		EnableElasticDisk: true,
+		NodeTypeID:        "m5.large",
+		CustomTags: map[string]string{
+			"Team": "data",
+		},
+	})
+	assert.NoError(t, err)
+
+	err = policy.ValidateCluster(Cluster{
+		NumWorkers: 1,
+		CustomTags: map[string]string{
+			"Team": "ml",
+		},
+	})
+	assert.EqualError(t, err, "cluster does not conform to policy abc: "+
+		"custom_tags.Team must be data, but is ml, num_workers must be at least 2, but is 1")
+
+	policy.Definition = "{"
+	err = policy.ValidateCluster(Cluster{})
+	qa.AssertErrorStartsWith(t, err, "cannot parse definition of cluster policy abc")
+}
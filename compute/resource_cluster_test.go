@@ -8,17 +8,31 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// withTestIdempotencyToken makes cluster creation requests predictable in the test
+func withTestIdempotencyToken(t *testing.T) {
+	previous := newIdempotencyToken
+	newIdempotencyToken = func() string {
+		return "tf-test"
+	}
+	t.Cleanup(func() {
+		newIdempotencyToken = previous
+	})
+}
+
 func TestResourceClusterCreate(t *testing.T) {
+	withTestIdempotencyToken(t)
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/clusters/create",
 				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
 					NumWorkers:             100,
 					ClusterName:            "Shared Autoscaling",
 					SparkVersion:           "7.1-scala12",
@@ -79,15 +93,166 @@ func TestResourceClusterCreate(t *testing.T) {
 	}.Apply(t)
 	assert.NoError(t, err, err)
 	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "tf-test", d.Get("idempotency_token"))
+}
+
+func TestResourceClusterCreate_IdenticalClustersGetDifferentTokens(t *testing.T) {
+	createCluster := func() *schema.ResourceData {
+		d, err := qa.ResourceFixture{
+			Fixtures: []qa.HTTPFixture{
+				{
+					Method:   "POST",
+					Resource: "/api/2.0/clusters/create",
+					Response: ClusterInfo{
+						ClusterID: "abc",
+						State:     ClusterStateRunning,
+					},
+				},
+				{
+					Method:       "GET",
+					ReuseRequest: true,
+					Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+					Response: ClusterInfo{
+						ClusterID:              "abc",
+						NumWorkers:             100,
+						ClusterName:            "Shared Autoscaling",
+						SparkVersion:           "7.1-scala12",
+						NodeTypeID:             "i3.xlarge",
+						AutoterminationMinutes: 15,
+						State:                  ClusterStateRunning,
+					},
+				},
+				{
+					Method:   "POST",
+					Resource: "/api/2.0/clusters/events",
+					Response: EventsResponse{
+						Events:     []ClusterEvent{},
+						TotalCount: 0,
+					},
+				},
+				{
+					Method:   "GET",
+					Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+					Response: ClusterLibraryStatuses{
+						LibraryStatuses: []LibraryStatus{},
+					},
+				},
+			},
+			Create:   true,
+			Resource: ResourceCluster(),
+			State: map[string]interface{}{
+				"autotermination_minutes": 15,
+				"cluster_name":            "Shared Autoscaling",
+				"spark_version":           "7.1-scala12",
+				"node_type_id":            "i3.xlarge",
+				"num_workers":             100,
+			},
+		}.Apply(t)
+		require.NoError(t, err, err)
+		return d
+	}
+	first := createCluster().Get("idempotency_token").(string)
+	second := createCluster().Get("idempotency_token").(string)
+	assert.NotEqual(t, first, second)
+	assert.True(t, strings.HasPrefix(first, "tf-"))
+	assert.True(t, len(first) <= 64)
+}
+
+func TestResourceClusterCreate_DefaultTags(t *testing.T) {
+	withTestIdempotencyToken(t)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					CustomTags: map[string]string{
+						"Owner":   "data-team",
+						"Project": "warehouse",
+					},
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+					State:     ClusterStateRunning,
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateRunning,
+					CustomTags: map[string]string{
+						"Owner":   "data-team",
+						"Project": "warehouse",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		DefaultTags: map[string]string{
+			"Owner":   "platform",
+			"Project": "warehouse",
+		},
+		HCL: `autotermination_minutes = 15
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 100
+		custom_tags = {
+			"Owner" = "data-team"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, map[string]interface{}{
+		"Owner": "data-team",
+	}, d.Get("custom_tags"))
 }
 
 func TestResourceClusterCreatePinned(t *testing.T) {
+	withTestIdempotencyToken(t)
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/clusters/create",
 				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
 					NumWorkers:             100,
 					ClusterName:            "Shared Autoscaling",
 					SparkVersion:           "7.1-scala12",
@@ -163,12 +328,14 @@ func TestResourceClusterCreatePinned(t *testing.T) {
 }
 
 func TestResourceClusterCreate_WithLibraries(t *testing.T) {
+	withTestIdempotencyToken(t)
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/clusters/create",
 				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
 					NumWorkers:             100,
 					SparkVersion:           "7.1-scala12",
 					NodeTypeID:             "i3.xlarge",
@@ -399,6 +566,8 @@ func TestResourceClusterRead(t *testing.T) {
 					NodeTypeID:             "i3.xlarge",
 					AutoterminationMinutes: 15,
 					State:                  ClusterStateRunning,
+					RuntimeEngine:          RuntimeEnginePhoton,
+					DataSecurityMode:       DataSecurityModeUserIsolation,
 					AutoScale: &AutoScale{
 						MaxWorkers: 4,
 					},
@@ -446,6 +615,8 @@ func TestResourceClusterRead(t *testing.T) {
 	assert.Equal(t, "Shared Autoscaling", d.Get("cluster_name"))
 	assert.Equal(t, "i3.xlarge", d.Get("node_type_id"))
 	assert.Equal(t, 4, d.Get("autoscale.0.max_workers"))
+	assert.Equal(t, "PHOTON", d.Get("runtime_engine"))
+	assert.Equal(t, "USER_ISOLATION", d.Get("data_security_mode"))
 	assert.Equal(t, "requests", d.Get("library.754562683.pypi.0.package"))
 	assert.Equal(t, "RUNNING", d.Get("state"))
 	assert.Equal(t, false, d.Get("is_pinned"))
@@ -912,12 +1083,14 @@ func TestResourceClusterDelete_Error(t *testing.T) {
 }
 
 func TestResourceClusterCreate_SingleNode(t *testing.T) {
+	withTestIdempotencyToken(t)
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/clusters/create",
 				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
 					NumWorkers:             0,
 					ClusterName:            "Single Node Cluster",
 					SparkVersion:           "7.3.x-scala12",
@@ -1095,6 +1268,8 @@ func TestModifyClusterRequestGcp(t *testing.T) {
 		InstancePoolID: "a",
 		GcpAttributes: &GcpAttributes{
 			UsePreemptibleExecutors: true,
+			ZoneID:                  "us-central1-a",
+			LocalSsdCount:           1,
 		},
 		EnableElasticDisk: true,
 		NodeTypeID:        "d",
@@ -1102,7 +1277,702 @@ func TestModifyClusterRequestGcp(t *testing.T) {
 	}
 	modifyClusterRequest(&c)
 	assert.Equal(t, false, c.GcpAttributes.UsePreemptibleExecutors)
+	assert.Equal(t, "", c.GcpAttributes.ZoneID)
+	assert.Equal(t, int32(0), c.GcpAttributes.LocalSsdCount)
 	assert.Equal(t, "", c.NodeTypeID)
 	assert.Equal(t, "", c.DriverNodeTypeID)
 	assert.Equal(t, false, c.EnableElasticDisk)
 }
+
+func TestResourceClusterCreate_PolicyViolation(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/policies/clusters/get?policy_id=abc",
+				Response: ClusterPolicy{
+					PolicyID: "abc",
+					Name:     "Small clusters",
+					Definition: `{
+						"spark_version": {"type": "fixed", "value": "7.3.x-scala2.12"},
+						"autoscale.max_workers": {"type": "range", "maxValue": 10},
+						"spark_conf.spark.databricks.io.cache.enabled": {"type": "fixed", "value": "true"}
+					}`,
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		policy_id = "abc"
+		autoscale {
+			min_workers = 1
+			max_workers = 20
+		}
+		spark_conf = {
+			"spark.databricks.io.cache.enabled" = "true"
+		}`,
+	}.ExpectError(t, "cluster does not conform to policy abc: "+
+		"autoscale.max_workers must be at most 10, but is 20, "+
+		"spark_version must be 7.3.x-scala2.12, but is 7.1-scala12")
+}
+
+func TestResourceClusterRead_InitScripts(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateTerminated,
+					InitScripts: []InitScriptStorageInfo{
+						{
+							Dbfs: &DbfsStorageInfo{
+								Destination: "dbfs:/init-scripts/a.sh",
+							},
+						},
+						{
+							Abfss: &AbfssStorageInfo{
+								Destination: "abfss://init@acme.dfs.core.windows.net/b.sh",
+							},
+						},
+						{
+							Gcs: &GcsStorageInfo{
+								Destination: "gs://acme/c.sh",
+							},
+						},
+						{
+							Workspace: &WorkspaceFileInfo{
+								Destination: "/Shared/d.sh",
+							},
+						},
+						{
+							File: &LocalFileInfo{
+								Destination: "file:/databricks/e.sh",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				ExpectedRequest: EventsRequest{
+					ClusterID:  "abc",
+					Limit:      1,
+					Order:      SortDescending,
+					EventTypes: []ClusterEventType{EvTypePinned, EvTypeUnpinned},
+				},
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{},
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Read:     true,
+		ID:       "abc",
+		New:      true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, 5, d.Get("init_scripts.#"))
+	assert.Equal(t, "dbfs:/init-scripts/a.sh", d.Get("init_scripts.0.dbfs.0.destination"))
+	assert.Equal(t, "abfss://init@acme.dfs.core.windows.net/b.sh", d.Get("init_scripts.1.abfss.0.destination"))
+	assert.Equal(t, "gs://acme/c.sh", d.Get("init_scripts.2.gcs.0.destination"))
+	assert.Equal(t, "/Shared/d.sh", d.Get("init_scripts.3.workspace.0.destination"))
+	assert.Equal(t, "file:/databricks/e.sh", d.Get("init_scripts.4.file.0.destination"))
+}
+
+func TestResourceClusterCreate_LogConfWrongEncryption(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		cluster_log_conf {
+			s3 {
+				destination = "s3a://acmecorp-main/cluster-logs"
+				region = "us-east-1"
+				enable_encryption = true
+				encryption_type = "aes"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [cluster_log_conf.#.s3.#.encryption_type] expected cluster_log_conf.0.s3.0.encryption_type to be one of [sse-s3 sse-kms], got aes")
+}
+
+func TestResourceClusterCreate_AzureWrongAvailability(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "Standard_DS3_v2"
+		num_workers = 1
+		azure_attributes {
+			availability = "SPOT"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [azure_attributes.#.availability] expected azure_attributes.0.availability to be one of [SPOT_AZURE ON_DEMAND_AZURE SPOT_WITH_FALLBACK_AZURE], got SPOT")
+}
+
+func TestResourceClusterCreate_WrongRuntimeEngine(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		runtime_engine = "TURBO"`,
+	}.ExpectError(t, "invalid config supplied. [runtime_engine] expected runtime_engine to be one of [PHOTON STANDARD], got TURBO")
+}
+
+func TestResourceClusterCreate_SingleUserWithoutName(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "SINGLE_USER"`,
+	}.ExpectError(t, "`single_user_name` is required for `data_security_mode = \"SINGLE_USER\"`")
+}
+
+func TestResourceClusterCreate_SharedWithSingleUser(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		data_security_mode = "USER_ISOLATION"
+		single_user_name = "me@example.com"`,
+	}.ExpectError(t, "`single_user_name` cannot be used with shared `data_security_mode = \"USER_ISOLATION\"`")
+}
+
+func TestValidateDataSecurityMode(t *testing.T) {
+	assert.NoError(t, validateDataSecurityMode("", "me@example.com"))
+	assert.NoError(t, validateDataSecurityMode("NONE", ""))
+	assert.NoError(t, validateDataSecurityMode("SINGLE_USER", "me@example.com"))
+	assert.NoError(t, validateDataSecurityMode("USER_ISOLATION", ""))
+	assert.Error(t, validateDataSecurityMode("LEGACY_SINGLE_USER", ""))
+	assert.Error(t, validateDataSecurityMode("LEGACY_TABLE_ACL", "me@example.com"))
+}
+
+func TestResourceClusterCreate_WrongZoneID(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "md-fleet.xlarge"
+		num_workers = 1
+		aws_attributes {
+			zone_id = "AUTO"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [aws_attributes.#.zone_id] invalid value for aws_attributes.0.zone_id (should be `auto` or lower-case availability zone, like `us-east-1a`)")
+}
+
+func TestAwsZoneAutoSuppressFunc(t *testing.T) {
+	assert.True(t, awsZoneAutoSuppressFunc("aws_attributes.0.zone_id", "us-east-1c", "auto", nil))
+	assert.False(t, awsZoneAutoSuppressFunc("aws_attributes.0.zone_id", "", "auto", nil))
+	assert.False(t, awsZoneAutoSuppressFunc("aws_attributes.0.zone_id", "auto", "us-east-1a", nil))
+}
+
+func TestResourceClusterCreate_AlwaysRunningConflict(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		always_running = true`,
+	}.ExpectError(t, "`always_running` must be specified only with `autotermination_minutes = 0`")
+}
+
+func TestResourceClusterUpdate_AlwaysRunningStartsTerminated(t *testing.T) {
+	terminated := ClusterInfo{
+		ClusterID:    "abc",
+		NumWorkers:   1,
+		ClusterName:  "Shared Autoscaling",
+		SparkVersion: "7.1-scala12",
+		NodeTypeID:   "i3.xlarge",
+		State:        ClusterStateTerminated,
+	}
+	running := terminated
+	running.State = ClusterStateRunning
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: terminated,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: terminated,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/start",
+				ExpectedRequest: ClusterID{
+					ClusterID: "abc",
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response:     running,
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"autotermination_minutes": "0",
+			"always_running":          "true",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "1",
+			"state":                   "TERMINATED",
+		},
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		num_workers = 1
+		autotermination_minutes = 0
+		always_running = true`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "RUNNING", d.Get("state"))
+}
+
+func TestResourceClusterUpdate_ResizeWithoutRestart(t *testing.T) {
+	running := ClusterInfo{
+		ClusterID:              "abc",
+		NumWorkers:             1,
+		ClusterName:            "Shared Autoscaling",
+		SparkVersion:           "7.1-scala12",
+		NodeTypeID:             "i3.xlarge",
+		AutoterminationMinutes: 15,
+		State:                  ClusterStateRunning,
+	}
+	resized := running
+	resized.NumWorkers = 5
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: running,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/resize",
+				ExpectedRequest: ResizeRequest{
+					ClusterID:  "abc",
+					NumWorkers: 5,
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response:     resized,
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "1",
+		},
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		autotermination_minutes = 15
+		num_workers = 5`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 5, d.Get("num_workers"))
+}
+
+func TestResourceClusterUpdate_ResizeTerminatedWithEdit(t *testing.T) {
+	terminated := ClusterInfo{
+		ClusterID:              "abc",
+		NumWorkers:             1,
+		ClusterName:            "Shared Autoscaling",
+		SparkVersion:           "7.1-scala12",
+		NodeTypeID:             "i3.xlarge",
+		AutoterminationMinutes: 15,
+		State:                  ClusterStateTerminated,
+	}
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				ReuseRequest: true,
+				Response:     terminated,
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/edit",
+				ExpectedRequest: Cluster{
+					ClusterID:              "abc",
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					Autoscale: &AutoScale{
+						MinWorkers: 1,
+						MaxWorkers: 4,
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				ReuseRequest: true,
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+		},
+		ID:       "abc",
+		Update:   true,
+		Resource: ResourceCluster(),
+		InstanceState: map[string]string{
+			"autotermination_minutes": "15",
+			"cluster_name":            "Shared Autoscaling",
+			"spark_version":           "7.1-scala12",
+			"node_type_id":            "i3.xlarge",
+			"num_workers":             "1",
+		},
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		autotermination_minutes = 15
+		autoscale {
+			min_workers = 1
+			max_workers = 4
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+}
+
+func TestResourceClusterCreate_NoWait(t *testing.T) {
+	withTestIdempotencyToken(t)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/libraries/install",
+				ExpectedRequest: ClusterLibraryList{
+					ClusterID: "abc",
+					Libraries: []Library{
+						{
+							Pypi: &PyPi{
+								Package: "seaborn==1.2.4",
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             100,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStatePending,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{
+						{
+							Library: &Library{
+								Pypi: &PyPi{
+									Package: "seaborn==1.2.4",
+								},
+							},
+							Status: "PENDING",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		node_type_id = "i3.xlarge"
+		autotermination_minutes = 15
+		num_workers = 100
+		no_wait = true
+		library {
+			pypi {
+				package = "seaborn==1.2.4"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "PENDING", d.Get("state"))
+}
+
+func TestResourceClusterRead_Import(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             2,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					NodeTypeID:             "i3.xlarge",
+					AutoterminationMinutes: 15,
+					State:                  ClusterStateTerminated,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{
+						{
+							Library: &Library{
+								Pypi: &PyPi{
+									Package: "requests",
+								},
+							},
+							Status: "INSTALLED",
+						},
+						{
+							Library: &Library{
+								Jar: "dbfs:/FileStore/jars/for-everyone.jar",
+							},
+							Status:                          "INSTALLED",
+							IsLibraryInstalledOnAllClusters: true,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceCluster(),
+		Read:     true,
+		ID:       "abc",
+		New:      true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, 1, d.Get("library.#"))
+	state := d.State().Attributes
+	assert.Equal(t, "false", state["always_running"])
+	assert.Equal(t, "false", state["no_wait"])
+	assert.Equal(t, "2", state["num_workers"])
+}
+
+func TestResourceClusterCreate_PoolWithAwsAttributes(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		instance_pool_id = "pool"
+		num_workers = 1
+		aws_attributes {
+			instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/data"
+			ebs_volume_count = 1
+		}`,
+	}.ExpectError(t, "`aws_attributes.0.ebs_volume_count` cannot be used together with `instance_pool_id`, as it's defined by the instance pool")
+}
+
+func TestResourceClusterCreate_PoolWithInstanceProfile(t *testing.T) {
+	withTestIdempotencyToken(t)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/create",
+				ExpectedRequest: Cluster{
+					IdempotencyToken:       "tf-test",
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					InstancePoolID:         "pool",
+					AutoterminationMinutes: 60,
+					AwsAttributes: &AwsAttributes{
+						InstanceProfileArn: "arn:aws:iam::999999999999:instance-profile/data",
+					},
+				},
+				Response: ClusterInfo{
+					ClusterID: "abc",
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+				Response: ClusterInfo{
+					ClusterID:              "abc",
+					NumWorkers:             1,
+					ClusterName:            "Shared Autoscaling",
+					SparkVersion:           "7.1-scala12",
+					InstancePoolID:         "pool",
+					AutoterminationMinutes: 60,
+					State:                  ClusterStateRunning,
+					AwsAttributes: &AwsAttributes{
+						InstanceProfileArn: "arn:aws:iam::999999999999:instance-profile/data",
+						Availability:       "SPOT",
+					},
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/clusters/events",
+				Response: EventsResponse{
+					Events:     []ClusterEvent{},
+					TotalCount: 0,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/libraries/cluster-status?cluster_id=abc",
+				Response: ClusterLibraryStatuses{
+					LibraryStatuses: []LibraryStatus{},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceCluster(),
+		HCL: `
+		cluster_name = "Shared Autoscaling"
+		spark_version = "7.1-scala12"
+		instance_pool_id = "pool"
+		num_workers = 1
+		aws_attributes {
+			instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/data"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "SPOT", d.Get("aws_attributes.0.availability"))
+}
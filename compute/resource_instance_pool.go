@@ -23,12 +23,14 @@ type InstancePoolsAPI struct {
 // Create creates the instance pool to given the instance pool configuration
 func (a InstancePoolsAPI) Create(instancePool InstancePool) (InstancePoolAndStats, error) {
 	var instancePoolInfo InstancePoolAndStats
+	instancePool.CustomTags = a.client.WithDefaultTags(instancePool.CustomTags)
 	err := a.client.Post(a.context, "/instance-pools/create", instancePool, &instancePoolInfo)
 	return instancePoolInfo, err
 }
 
 // Update edits the configuration of a instance pool to match the provided attributes and size
 func (a InstancePoolsAPI) Update(ip InstancePool) error {
+	ip.CustomTags = a.client.WithDefaultTags(ip.CustomTags)
 	return a.client.Post(a.context, "/instance-pools/edit", ip, nil)
 }
 
@@ -65,10 +67,13 @@ func ResourceInstancePool() *schema.Resource {
 		s["disk_spec"].ForceNew = true
 		s["enable_elastic_disk"].ForceNew = true
 		s["enable_elastic_disk"].Default = true
-		s["aws_attributes"].ConflictsWith = []string{"azure_attributes"}
-		s["azure_attributes"].ConflictsWith = []string{"aws_attributes"}
+		s["gcp_attributes"].ForceNew = true
+		s["aws_attributes"].ConflictsWith = []string{"azure_attributes", "gcp_attributes"}
+		s["azure_attributes"].ConflictsWith = []string{"aws_attributes", "gcp_attributes"}
+		s["gcp_attributes"].ConflictsWith = []string{"aws_attributes", "azure_attributes"}
 		s["aws_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("aws_attributes.#")
 		s["azure_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("azure_attributes.#")
+		s["gcp_attributes"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("gcp_attributes.#")
 		if v, err := common.SchemaPath(s, "aws_attributes", "availability"); err == nil {
 			v.ForceNew = true
 			v.Default = AwsAvailabilitySpot
@@ -79,6 +84,8 @@ func ResourceInstancePool() *schema.Resource {
 		}
 		if v, err := common.SchemaPath(s, "aws_attributes", "zone_id"); err == nil {
 			v.ForceNew = true
+			v.ValidateFunc = validateAwsZoneID
+			v.DiffSuppressFunc = awsZoneAutoSuppressFunc
 		}
 		if v, err := common.SchemaPath(s, "aws_attributes", "spot_bid_price_percent"); err == nil {
 			v.ForceNew = true
@@ -94,6 +101,14 @@ func ResourceInstancePool() *schema.Resource {
 		}
 		if v, err := common.SchemaPath(s, "azure_attributes", "spot_bid_max_price"); err == nil {
 			v.ForceNew = true
+			// -1 means the lowest price, that is no higher than on-demand one
+			v.ValidateFunc = validation.FloatAtLeast(-1)
+		}
+		if v, err := common.SchemaPath(s, "gcp_attributes", "zone_id"); err == nil {
+			v.ForceNew = true
+		}
+		if v, err := common.SchemaPath(s, "gcp_attributes", "local_ssd_count"); err == nil {
+			v.ForceNew = true
 		}
 		if v, err := common.SchemaPath(s, "disk_spec", "disk_type", "azure_disk_volume_type"); err == nil {
 			v.ForceNew = true
@@ -141,6 +156,8 @@ func ResourceInstancePool() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			ip.CustomTags = c.WithoutDefaultTags(ip.CustomTags, d.Get("custom_tags"))
+			ip.CustomTags = withoutServerManaged(ip.CustomTags, d.Get("custom_tags"), serverManagedTags)
 			return common.StructToData(ip, s, d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -154,5 +171,10 @@ func ResourceInstancePool() *schema.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewInstancePoolsAPI(ctx, c).Delete(d.Id())
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultProvisionTimeout),
+			Update: schema.DefaultTimeout(DefaultProvisionTimeout),
+			Delete: schema.DefaultTimeout(DefaultProvisionTimeout),
+		},
 	}.ToResource()
 }
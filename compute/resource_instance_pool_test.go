@@ -115,6 +115,130 @@ func TestResourceInstancePoolCreate(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceInstancePoolCreate_Gcp(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Shared Pool",
+					MaxCapacity:                        100,
+					NodeTypeID:                         "n1-standard-4",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					GcpAttributes: &InstancePoolGcpAttributes{
+						ZoneID:        "us-central1-a",
+						LocalSsdCount: 2,
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Shared Pool",
+					MaxCapacity:                        100,
+					NodeTypeID:                         "n1-standard-4",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					GcpAttributes: &InstancePoolGcpAttributes{
+						ZoneID:        "us-central1-a",
+						LocalSsdCount: 2,
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Shared Pool"
+		max_capacity = 100
+		node_type_id = "n1-standard-4"
+		idle_instance_autotermination_minutes = 15
+		gcp_attributes {
+			zone_id = "us-central1-a"
+			local_ssd_count = 2
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, 2, d.Get("gcp_attributes.0.local_ssd_count"))
+}
+
+func TestResourceInstancePoolCreate_AzureSpot(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-pools/create",
+				ExpectedRequest: InstancePool{
+					InstancePoolName:                   "Spot Pool",
+					MaxCapacity:                        100,
+					NodeTypeID:                         "Standard_DS3_v2",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AzureAttributes: &InstancePoolAzureAttributes{
+						Availability:    AzureAvailabilitySpot,
+						SpotBidMaxPrice: -1,
+					},
+				},
+				Response: InstancePoolAndStats{
+					InstancePoolID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-pools/get?instance_pool_id=abc",
+				Response: InstancePoolAndStats{
+					InstancePoolID:                     "abc",
+					InstancePoolName:                   "Spot Pool",
+					MaxCapacity:                        100,
+					NodeTypeID:                         "Standard_DS3_v2",
+					IdleInstanceAutoTerminationMinutes: 15,
+					EnableElasticDisk:                  true,
+					AzureAttributes: &InstancePoolAzureAttributes{
+						Availability:    AzureAvailabilitySpot,
+						SpotBidMaxPrice: -1,
+					},
+				},
+			},
+		},
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Spot Pool"
+		max_capacity = 100
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "SPOT_AZURE"
+			spot_bid_max_price = -1
+		}`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "SPOT_AZURE", d.Get("azure_attributes.0.availability"))
+}
+
+func TestResourceInstancePoolCreate_AzureWrongBidPrice(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceInstancePool(),
+		HCL: `
+		instance_pool_name = "Spot Pool"
+		node_type_id = "Standard_DS3_v2"
+		idle_instance_autotermination_minutes = 15
+		azure_attributes {
+			availability = "SPOT_AZURE"
+			spot_bid_max_price = -2
+		}`,
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. [azure_attributes.#.spot_bid_max_price] expected azure_attributes.0.spot_bid_max_price to be at least (-1.000000), got -2.000000")
+}
+
 func TestResourceInstancePoolCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -3,7 +3,8 @@ package compute
 import (
 	"context"
 	"fmt"
-	"log"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -26,10 +27,24 @@ type JobsAPI struct {
 	context context.Context
 }
 
-// List all jobs
+// List all jobs, following pages until there are no more
 func (a JobsAPI) List() (l JobList, err error) {
-	err = a.client.Get(a.context, "/jobs/list", nil, &l)
-	return
+	// first page is requested without parameters, as Jobs API 2.0 returns all jobs in that case
+	var request interface{}
+	offset := int32(0)
+	for {
+		var page JobList
+		err = a.client.Get(a.context, "/jobs/list", request, &page)
+		if err != nil {
+			return
+		}
+		l.Jobs = append(l.Jobs, page.Jobs...)
+		if !page.HasMore || len(page.Jobs) == 0 {
+			return
+		}
+		offset += int32(len(page.Jobs))
+		request = JobListRequest{Offset: offset}
+	}
 }
 
 // RunsList ...
@@ -51,7 +66,7 @@ func (a JobsAPI) RunsCancel(runID int64, timeout time.Duration) error {
 }
 
 func (a JobsAPI) waitForRunState(runID int64, desiredState string, timeout time.Duration) error {
-	return resource.RetryContext(a.context, timeout, func() *resource.RetryError {
+	return a.client.RetryContext(a.context, timeout, func() *resource.RetryError {
 		jobRun, err := a.RunsGet(runID)
 		if err != nil {
 			return resource.NonRetryableError(
@@ -66,6 +81,13 @@ func (a JobsAPI) waitForRunState(runID int64, desiredState string, timeout time.
 				fmt.Errorf("cannot get job %s: %s",
 					desiredState, state.StateMessage))
 		}
+		if desiredState == "RUNNING" && (state.LifeCycleState == "TERMINATED" ||
+			state.LifeCycleState == "SKIPPED") {
+			// run won't come back to life, so there's no point waiting for the timeout
+			return resource.NonRetryableError(
+				fmt.Errorf("run %d is %s before reaching RUNNING: %s",
+					runID, state.LifeCycleState, state.StateMessage))
+		}
 		return resource.RetryableError(
 			fmt.Errorf("run is %s: %s",
 				state.LifeCycleState,
@@ -100,7 +122,7 @@ func (a JobsAPI) Start(jobID int64, timeout time.Duration) error {
 }
 
 func (a JobsAPI) Restart(id string, timeout time.Duration) error {
-	jobID, err := strconv.ParseInt(id, 10, 32)
+	jobID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		return err
 	}
@@ -128,17 +150,220 @@ func (a JobsAPI) Restart(id string, timeout time.Duration) error {
 
 // Create creates a job on the workspace given the job settings
 func (a JobsAPI) Create(jobSettings JobSettings) (Job, error) {
+	if jobSettings.isMultiTask() {
+		jobSettings.Format = JobFormatMultiTask
+	}
 	var job Job
+	jobSettings.withDefaultTags(a.client)
 	err := a.client.Post(a.context, "/jobs/create", jobSettings, &job)
 	return job, err
 }
 
+func (js *JobSettings) withDefaultTags(client *common.DatabricksClient) {
+	if js.NewCluster != nil {
+		cluster := *js.NewCluster
+		cluster.CustomTags = client.WithDefaultTags(cluster.CustomTags)
+		js.NewCluster = &cluster
+	}
+	for i, task := range js.Tasks {
+		if task.NewCluster == nil {
+			continue
+		}
+		cluster := *task.NewCluster
+		cluster.CustomTags = client.WithDefaultTags(cluster.CustomTags)
+		js.Tasks[i].NewCluster = &cluster
+	}
+	for i, jc := range js.JobClusters {
+		if jc.NewCluster == nil {
+			continue
+		}
+		cluster := *jc.NewCluster
+		cluster.CustomTags = client.WithDefaultTags(cluster.CustomTags)
+		js.JobClusters[i].NewCluster = &cluster
+	}
+}
+
+// isMultiTask returns true, if job has tasks or shared job clusters
+func (js *JobSettings) isMultiTask() bool {
+	return len(js.Tasks) > 0 || len(js.JobClusters) > 0 || js.Format == JobFormatMultiTask
+}
+
+// needsJobsAPI21 returns true, if job has to be handled by Jobs API 2.1
+func (js *JobSettings) needsJobsAPI21() bool {
+	return js.isMultiTask() || js.GitSource != nil || js.Continuous != nil || js.Trigger != nil ||
+		js.Queue != nil || js.NotificationSettings != nil || js.WebhookNotifications != nil ||
+		js.Health != nil || len(js.Tags) > 0 || js.EditMode != "" || js.Deployment != nil
+}
+
+// gitProviders maps well-known Git hosts to values of `git_source.provider`
+var gitProviders = map[string]string{
+	"github.com":       "gitHub",
+	"gitlab.com":       "gitLab",
+	"bitbucket.org":    "bitbucketCloud",
+	"dev.azure.com":    "azureDevOpsServices",
+	"visualstudio.com": "azureDevOpsServices",
+}
+
+// withGitProvider guesses `git_source.provider` from the repository URL, when it's not set
+func (js *JobSettings) withGitProvider() error {
+	if js.GitSource == nil || js.GitSource.Provider != "" {
+		return nil
+	}
+	u, err := url.Parse(js.GitSource.URL)
+	if err != nil {
+		return err
+	}
+	for host, provider := range gitProviders {
+		if u.Host == host || strings.HasSuffix(u.Host, "."+host) {
+			js.GitSource.Provider = provider
+			return nil
+		}
+	}
+	if strings.HasPrefix(u.Host, "git-codecommit.") && strings.HasSuffix(u.Host, ".amazonaws.com") {
+		js.GitSource.Provider = "awsCodeCommit"
+		return nil
+	}
+	return fmt.Errorf("git_source.provider must be specified for %s", js.GitSource.URL)
+}
+
+// jobsAPIContext selects Jobs API 2.1 for multi-task jobs and jobs from Git
+func jobsAPIContext(ctx context.Context, jobsAPI21 bool) context.Context {
+	if jobsAPI21 {
+		return context.WithValue(ctx, common.Api, common.API_2_1)
+	}
+	return ctx
+}
+
+// validate checks every new cluster definition of a job and clusters,
+// that are used by spark submit, pipeline and SQL tasks
+func (js *JobSettings) validate() error {
+	if js.NewCluster != nil {
+		if err := validateClusterDefinition(*js.NewCluster); err != nil {
+			return err
+		}
+	}
+	if js.SparkSubmitTask != nil {
+		if err := validateSparkSubmitCluster(js.NewCluster); err != nil {
+			return err
+		}
+	}
+	jobClusters := map[string]*Cluster{}
+	for _, jc := range js.JobClusters {
+		jobClusters[jc.JobClusterKey] = jc.NewCluster
+		if jc.NewCluster == nil {
+			continue
+		}
+		if err := validateClusterDefinition(*jc.NewCluster); err != nil {
+			return fmt.Errorf("job cluster %s: %w", jc.JobClusterKey, err)
+		}
+	}
+	environments := map[string]bool{}
+	for _, env := range js.Environments {
+		environments[env.EnvironmentKey] = true
+	}
+	for _, task := range js.Tasks {
+		hasCluster := task.NewCluster != nil || task.ExistingClusterID != "" || task.JobClusterKey != ""
+		if task.EnvironmentKey != "" {
+			if err := task.validateEnvironment(environments, hasCluster); err != nil {
+				return err
+			}
+		}
+		if task.PipelineTask != nil && hasCluster {
+			return fmt.Errorf("task %s: pipeline_task runs on clusters of the pipeline, "+
+				"so new_cluster, existing_cluster_id or job_cluster_key cannot be set", task.TaskKey)
+		}
+		if task.SQLTask != nil {
+			if hasCluster {
+				return fmt.Errorf("task %s: sql_task runs on SQL warehouse, "+
+					"so new_cluster, existing_cluster_id or job_cluster_key cannot be set", task.TaskKey)
+			}
+			if err := task.SQLTask.validate(); err != nil {
+				return fmt.Errorf("task %s: %w", task.TaskKey, err)
+			}
+		}
+		newCluster := task.NewCluster
+		if task.JobClusterKey != "" {
+			jobCluster, ok := jobClusters[task.JobClusterKey]
+			if !ok {
+				return fmt.Errorf("task %s refers to unknown job_cluster_key: %s",
+					task.TaskKey, task.JobClusterKey)
+			}
+			newCluster = jobCluster
+		}
+		if task.SparkSubmitTask != nil {
+			if err := validateSparkSubmitCluster(newCluster); err != nil {
+				return fmt.Errorf("task %s: %w", task.TaskKey, err)
+			}
+		}
+		if task.NewCluster == nil {
+			continue
+		}
+		if err := validateClusterDefinition(*task.NewCluster); err != nil {
+			return fmt.Errorf("task %s: %w", task.TaskKey, err)
+		}
+	}
+	return nil
+}
+
+// validateEnvironment checks, that only python tasks without clusters run in declared serverless environments
+func (task *JobTaskSettings) validateEnvironment(environments map[string]bool, hasCluster bool) error {
+	if !environments[task.EnvironmentKey] {
+		return fmt.Errorf("task %s refers to unknown environment_key: %s",
+			task.TaskKey, task.EnvironmentKey)
+	}
+	if hasCluster {
+		return fmt.Errorf("task %s: environment_key runs on serverless compute, "+
+			"so new_cluster, existing_cluster_id or job_cluster_key cannot be set", task.TaskKey)
+	}
+	if task.SparkPythonTask == nil {
+		return fmt.Errorf("task %s: environment_key can only be used with spark_python_task", task.TaskKey)
+	}
+	if len(task.Libraries) > 0 {
+		return fmt.Errorf("task %s: libraries of serverless tasks must be "+
+			"specified as dependencies of the environment", task.TaskKey)
+	}
+	return nil
+}
+
+// validate checks, that SQL task refers to exactly one query, dashboard, alert or file
+func (st *SQLTask) validate() error {
+	refs := 0
+	for _, set := range []bool{st.Query != nil, st.Dashboard != nil, st.Alert != nil, st.File != nil} {
+		if set {
+			refs++
+		}
+	}
+	if refs != 1 {
+		return fmt.Errorf("sql_task must have exactly one of query, dashboard, alert or file blocks")
+	}
+	return nil
+}
+
+// validateSparkSubmitCluster checks, that spark submit runs on a new cluster,
+// which is neither created from an instance pool nor governed by a cluster policy
+func validateSparkSubmitCluster(newCluster *Cluster) error {
+	if newCluster == nil {
+		return fmt.Errorf("spark_submit_task can only run on new_cluster")
+	}
+	if newCluster.InstancePoolID != "" || newCluster.DriverInstancePoolID != "" {
+		return fmt.Errorf("spark_submit_task cannot run on clusters from instance pools")
+	}
+	if newCluster.PolicyID != "" {
+		return fmt.Errorf("spark_submit_task cannot run on clusters with policy_id")
+	}
+	return nil
+}
+
 // Update updates a job given the id and a new set of job settings
 func (a JobsAPI) Update(id string, jobSettings JobSettings) error {
-	jobID, err := strconv.ParseInt(id, 10, 32)
+	jobID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		return err
 	}
+	if jobSettings.isMultiTask() {
+		jobSettings.Format = JobFormatMultiTask
+	}
+	jobSettings.withDefaultTags(a.client)
 	return wrapMissingJobError(a.client.Post(a.context, "/jobs/reset", UpdateJobRequest{
 		JobID:       jobID,
 		NewSettings: &jobSettings,
@@ -147,7 +372,7 @@ func (a JobsAPI) Update(id string, jobSettings JobSettings) error {
 
 // Read returns the job object with all the attributes
 func (a JobsAPI) Read(id string) (job Job, err error) {
-	jobID, err := strconv.ParseInt(id, 10, 32)
+	jobID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		return
 	}
@@ -157,9 +382,20 @@ func (a JobsAPI) Read(id string) (job Job, err error) {
 	return
 }
 
+// RunsCancelAll cancels all active runs of the job given a job id
+func (a JobsAPI) RunsCancelAll(id string) error {
+	jobID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	return wrapMissingJobError(a.client.Post(a.context, "/jobs/runs/cancel-all", map[string]int64{
+		"job_id": jobID,
+	}, nil), id)
+}
+
 // Delete deletes the job given a job id
 func (a JobsAPI) Delete(id string) error {
-	jobID, err := strconv.ParseInt(id, 10, 32)
+	jobID, err := strconv.ParseInt(id, 10, 64)
 	if err != nil {
 		return err
 	}
@@ -188,40 +424,139 @@ func wrapMissingJobError(err error, id string) error {
 	return err
 }
 
+// updateJobClusterSchema customizes every `new_cluster` block of a job, either
+// on the job level or on the level of an individual task
+func updateJobClusterSchema(s map[string]*schema.Schema, path ...string) {
+	ncs, err := common.SchemaPath(s, path...)
+	if err != nil {
+		return
+	}
+	cs := ncs.Elem.(*schema.Resource).Schema
+	if p, ok := cs["num_workers"]; ok {
+		p.Optional = true
+		p.Default = 0
+		p.Type = schema.TypeInt
+		p.ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(0))
+		p.Required = false
+	}
+	if p, err := common.SchemaPath(cs, "docker_image", "basic_auth", "password"); err == nil {
+		p.Sensitive = true
+	}
+	if v, ok := cs["spark_conf"]; ok {
+		v.DiffSuppressFunc = sparkConfDiffSuppressFunc
+	}
+	for _, block := range []string{"aws_attributes", "azure_attributes", "gcp_attributes"} {
+		if v, ok := cs[block]; ok {
+			v.DiffSuppressFunc = emptyNestedBlockSuppressFunc("new_cluster.0." + block)
+		}
+	}
+	if v, err := common.SchemaPath(cs, "aws_attributes", "zone_id"); err == nil {
+		v.ValidateFunc = validateAwsZoneID
+		v.DiffSuppressFunc = awsZoneAutoSuppressFunc
+	}
+	if v, ok := cs["runtime_engine"]; ok {
+		v.ValidateFunc = validation.StringInSlice([]string{
+			RuntimeEnginePhoton,
+			RuntimeEngineStandard,
+		}, false)
+	}
+	if v, ok := cs["data_security_mode"]; ok {
+		v.ValidateFunc = validation.StringInSlice(dataSecurityModes, false)
+	}
+}
+
+// emptyNestedBlockSuppressFunc disables removal of empty block, regardless of
+// the position of enclosing `new_cluster` or `task` within the job
+func emptyNestedBlockSuppressFunc(path string) func(k, old, new string, d *schema.ResourceData) bool {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return strings.HasSuffix(k, path+".#") && old == "1" && new == "0"
+	}
+}
+
 var jobSchema = common.StructToSchema(JobSettings{},
 	func(s map[string]*schema.Schema) map[string]*schema.Schema {
-		if p, err := common.SchemaPath(s, "new_cluster", "num_workers"); err == nil {
-			p.Optional = true
-			p.Default = 0
-			p.Type = schema.TypeInt
-			p.ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(0))
-			p.Required = false
+		updateJobClusterSchema(s, "new_cluster")
+		updateJobClusterSchema(s, "task", "new_cluster")
+		updateJobClusterSchema(s, "job_cluster", "new_cluster")
+		gitReferences := []string{"git_source.0.branch", "git_source.0.tag", "git_source.0.commit"}
+		for _, ref := range []string{"branch", "tag", "commit"} {
+			if p, err := common.SchemaPath(s, "git_source", ref); err == nil {
+				p.ExactlyOneOf = gitReferences
+			}
+		}
+		if p, err := common.SchemaPath(s, "task", "dbt_task", "commands"); err == nil {
+			p.Elem.(*schema.Schema).ValidateFunc = validation.StringMatch(
+				regexp.MustCompile(`^dbt\s`), "dbt commands must start with `dbt`")
+		}
+		for _, path := range [][]string{{"health", "rules"}, {"task", "health", "rules"}} {
+			p, err := common.SchemaPath(s, path...)
+			if err != nil {
+				continue
+			}
+			rule := p.Elem.(*schema.Resource).Schema
+			rule["metric"].ValidateFunc = validation.StringInSlice([]string{"RUN_DURATION_SECONDS"}, false)
+			rule["op"].ValidateFunc = validation.StringInSlice([]string{"GREATER_THAN"}, false)
+			rule["value"].ValidateFunc = validation.IntAtLeast(1)
+		}
+		if p, err := common.SchemaPath(s, "git_source", "provider"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"gitHub", "gitHubEnterprise",
+				"bitbucketCloud", "bitbucketServer", "azureDevOpsServices",
+				"gitLab", "gitLabEnterpriseEdition", "awsCodeCommit"}, false)
+		}
+		s["edit_mode"].ValidateFunc = validation.StringInSlice([]string{"UI_LOCKED", "EDITABLE"}, false)
+		if p, err := common.SchemaPath(s, "deployment", "kind"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"BUNDLE"}, false)
 		}
 		if p, err := common.SchemaPath(s, "schedule", "pause_status"); err == nil {
 			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
-		if v, err := common.SchemaPath(s, "new_cluster", "spark_conf"); err == nil {
-			v.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
-				isPossiblyLegacyConfig := k == "new_cluster.0.spark_conf.%" && old == "1" && new == "0"
-				isLegacyConfig := k == "new_cluster.0.spark_conf.spark.databricks.delta.preview.enabled"
-				if isPossiblyLegacyConfig || isLegacyConfig {
-					log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
-					return true
-				}
-				return false
+		if p, err := common.SchemaPath(s, "continuous", "pause_status"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
+		}
+		s["continuous"].ConflictsWith = []string{"schedule", "always_running", "trigger"}
+		s["trigger"].ConflictsWith = []string{"schedule", "always_running", "continuous"}
+		for _, trigger := range []string{"file_arrival", "table_update"} {
+			p, err := common.SchemaPath(s, "trigger", trigger)
+			if err != nil {
+				continue
 			}
+			p.ExactlyOneOf = []string{"trigger.0.file_arrival", "trigger.0.table_update"}
+			// service doesn't check for new files or table updates more often than once a minute
+			conf := p.Elem.(*schema.Resource).Schema
+			conf["min_time_between_triggers_seconds"].ValidateFunc = validation.IntAtLeast(60)
+			conf["wait_after_last_change_seconds"].ValidateFunc = validation.IntAtLeast(60)
 		}
-		if v, err := common.SchemaPath(s, "new_cluster", "aws_attributes"); err == nil {
-			v.DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("new_cluster.0.aws_attributes.#")
+		if p, err := common.SchemaPath(s, "trigger", "table_update", "condition"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"ANY_UPDATED", "ALL_UPDATED"}, false)
 		}
-		if v, err := common.SchemaPath(s, "new_cluster", "azure_attributes"); err == nil {
-			v.DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("new_cluster.0.azure_attributes.#")
+		if p, err := common.SchemaPath(s, "trigger", "pause_status"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"PAUSED", "UNPAUSED"}, false)
 		}
-		if v, err := common.SchemaPath(s, "new_cluster", "gcp_attributes"); err == nil {
-			v.DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("new_cluster.0.gcp_attributes.#")
+		for _, conflicting := range []string{"existing_cluster_id", "new_cluster",
+			"notebook_task", "spark_jar_task", "spark_python_task", "spark_submit_task"} {
+			s[conflicting].ConflictsWith = []string{"task", "job_cluster", "environment"}
 		}
 		s["email_notifications"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("email_notifications.#")
-		s["max_concurrent_runs"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntAtLeast(1))
+		s["notification_settings"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("notification_settings.#")
+		s["webhook_notifications"].DiffSuppressFunc = common.MakeEmptyBlockSuppressFunc("webhook_notifications.#")
+		for _, block := range []string{"email_notifications", "notification_settings", "webhook_notifications"} {
+			if p, err := common.SchemaPath(s, "task", block); err == nil {
+				p.DiffSuppressFunc = emptyNestedBlockSuppressFunc(block)
+			}
+		}
+		for _, prefix := range [][]string{{}, {"task"}} {
+			limits := map[string]int{
+				"max_retries":               -1, // retry indefinitely
+				"min_retry_interval_millis": 0,
+				"timeout_seconds":           0,
+			}
+			for field, min := range limits {
+				if p, err := common.SchemaPath(s, append(prefix, field)...); err == nil {
+					p.ValidateFunc = validation.IntAtLeast(min)
+				}
+			}
+		}
+		s["max_concurrent_runs"].ValidateDiagFunc = validation.ToDiagFunc(validation.IntBetween(1, 1000))
 		s["url"] = &schema.Schema{
 			Type:     schema.TypeString,
 			Computed: true,
@@ -234,6 +569,52 @@ var jobSchema = common.StructToSchema(JobSettings{},
 		return s
 	})
 
+// withoutManagedClusterConf strips default and server-managed tags and spark
+// configuration from the new cluster of a job, that is stored under prefix
+func withoutManagedClusterConf(c *common.DatabricksClient, newCluster *Cluster,
+	d *schema.ResourceData, prefix string) {
+	if newCluster == nil {
+		return
+	}
+	newCluster.CustomTags = c.WithoutDefaultTags(
+		newCluster.CustomTags, d.Get(prefix+".custom_tags"))
+	newCluster.CustomTags = withoutServerManaged(newCluster.CustomTags,
+		d.Get(prefix+".custom_tags"), serverManagedTags)
+	newCluster.SparkConf = withoutServerManaged(newCluster.SparkConf,
+		d.Get(prefix+".spark_conf"), serverManagedSparkConf)
+}
+
+// validateExistingClusters checks, that changed `existing_cluster_id` of the job
+// and its tasks don't refer to job clusters, which are terminated after their run
+func validateExistingClusters(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
+	keys := []string{"existing_cluster_id"}
+	for i := 0; i < d.Get("task.#").(int); i++ {
+		keys = append(keys, fmt.Sprintf("task.%d.existing_cluster_id", i))
+	}
+	clustersAPI := NewClustersAPI(ctx, c)
+	checked := map[string]bool{}
+	for _, key := range keys {
+		clusterID := d.Get(key).(string)
+		// clusters, that are created within the same apply, are not known yet
+		if clusterID == "" || checked[clusterID] || !d.HasChange(key) {
+			continue
+		}
+		checked[clusterID] = true
+		ci, err := clustersAPI.Get(clusterID)
+		if e, ok := err.(common.APIError); ok && e.IsMissing() {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if ci.ClusterSource == "JOB" {
+			return fmt.Errorf("%s: %s is a job cluster, use new_cluster or "+
+				"job_cluster_key instead", key, clusterID)
+		}
+	}
+	return nil
+}
+
 // ResourceJob ...
 func ResourceJob() *schema.Resource {
 	return common.Resource{
@@ -249,7 +630,7 @@ func ResourceJob() *schema.Resource {
 			if alwaysRunning && maxConcurrentRuns > 1 {
 				return fmt.Errorf("`always_running` must be specified only with `max_concurrent_runs = 1`")
 			}
-			return nil
+			return validateExistingClusters(ctx, d, c)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var js JobSettings
@@ -257,12 +638,13 @@ func ResourceJob() *schema.Resource {
 			if err != nil {
 				return err
 			}
-			if js.NewCluster != nil {
-				if err = validateClusterDefinition(*js.NewCluster); err != nil {
-					return err
-				}
+			if err = js.validate(); err != nil {
+				return err
 			}
-			jobsAPI := NewJobsAPI(ctx, c)
+			if err = js.withGitProvider(); err != nil {
+				return err
+			}
+			jobsAPI := NewJobsAPI(jobsAPIContext(ctx, js.needsJobsAPI21()), c)
 			job, err := jobsAPI.Create(js)
 			if err != nil {
 				return err
@@ -274,12 +656,42 @@ func ResourceJob() *schema.Resource {
 			return nil
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			job, err := NewJobsAPI(ctx, c).Read(d.Id())
+			jobsAPI21 := d.Get("format").(string) == JobFormatMultiTask ||
+				d.Get("task.#").(int) > 0 || d.Get("job_cluster.#").(int) > 0 ||
+				d.Get("git_source.#").(int) > 0 || d.Get("continuous.#").(int) > 0 ||
+				d.Get("trigger.#").(int) > 0 ||
+				d.Get("queue.#").(int) > 0 || d.Get("notification_settings.#").(int) > 0 ||
+				d.Get("webhook_notifications.#").(int) > 0 || d.Get("health.#").(int) > 0 ||
+				len(d.Get("tags").(map[string]interface{})) > 0 ||
+				d.Get("edit_mode").(string) != "" || d.Get("deployment.#").(int) > 0
+			job, err := NewJobsAPI(jobsAPIContext(ctx, jobsAPI21), c).Read(d.Id())
 			if err != nil {
 				return err
 			}
+			if !jobsAPI21 && job.Settings.Format == JobFormatMultiTask {
+				// imported jobs have nothing in state yet, but tasks and
+				// job clusters are returned only by Jobs API 2.1
+				job, err = NewJobsAPI(jobsAPIContext(ctx, true), c).Read(d.Id())
+				if err != nil {
+					return err
+				}
+			}
 			d.Set("url", c.FormatURL("#job/", d.Id()))
-			return common.StructToData(*job.Settings, jobSchema, d)
+			withoutManagedClusterConf(c, job.Settings.NewCluster, d, "new_cluster.0")
+			for i := range job.Settings.Tasks {
+				withoutManagedClusterConf(c, job.Settings.Tasks[i].NewCluster, d,
+					fmt.Sprintf("task.%d.new_cluster.0", i))
+			}
+			for i := range job.Settings.JobClusters {
+				withoutManagedClusterConf(c, job.Settings.JobClusters[i].NewCluster, d,
+					fmt.Sprintf("job_cluster.%d.new_cluster.0", i))
+			}
+			if err = common.StructToData(*job.Settings, jobSchema, d); err != nil {
+				return err
+			}
+			// explicitly persist default of provider-only attribute, so that
+			// imported jobs have no changes on the next plan
+			return d.Set("always_running", d.Get("always_running"))
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			var js JobSettings
@@ -287,13 +699,13 @@ func ResourceJob() *schema.Resource {
 			if err != nil {
 				return err
 			}
-			if js.NewCluster != nil {
-				err = validateClusterDefinition(*js.NewCluster)
-				if err != nil {
-					return err
-				}
+			if err = js.validate(); err != nil {
+				return err
 			}
-			jobsAPI := NewJobsAPI(ctx, c)
+			if err = js.withGitProvider(); err != nil {
+				return err
+			}
+			jobsAPI := NewJobsAPI(jobsAPIContext(ctx, js.needsJobsAPI21()), c)
 			err = jobsAPI.Update(d.Id(), js)
 			if err != nil {
 				return err
@@ -304,7 +716,15 @@ func ResourceJob() *schema.Resource {
 			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			return NewJobsAPI(ctx, c).Delete(d.Id())
+			jobsAPI := NewJobsAPI(ctx, c)
+			if d.Get("continuous.#").(int) > 0 {
+				// continuous job always has an active run, that is stopped before removal
+				jobsAPI = NewJobsAPI(jobsAPIContext(ctx, true), c)
+				if err := jobsAPI.RunsCancelAll(d.Id()); err != nil {
+					return err
+				}
+			}
+			return jobsAPI.Delete(d.Id())
 		},
 	}.ToResource()
 }
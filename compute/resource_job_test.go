@@ -13,35 +13,2208 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// interactiveClusterFixture is requested during plan to check, that `existing_cluster_id` is not a job cluster
+var interactiveClusterFixture = qa.HTTPFixture{
+	Method:       "GET",
+	Resource:     "/api/2.0/clusters/get?cluster_id=abc",
+	ReuseRequest: true,
+	Response: ClusterInfo{
+		ClusterID:     "abc",
+		ClusterSource: "UI",
+	},
+}
+
 func TestResourceJobCreate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/create",
+				ExpectedRequest: JobSettings{
+					ExistingClusterID: "abc",
+					SparkJarTask: &SparkJarTask{
+						MainClassName: "com.labs.BarMain",
+					},
+					Libraries: []Library{
+						{
+							Jar: "dbfs://ff/gg/hh.jar",
+						},
+						{
+							Jar: "dbfs://aa/bb/cc.jar",
+						},
+					},
+					Schedule: &CronSchedule{
+						QuartzCronExpression: "0 15 22 ? * *",
+						TimezoneID:           "America/Los_Angeles",
+						PauseStatus:          "PAUSED",
+					},
+					Name:                   "Featurizer",
+					MaxRetries:             3,
+					MinRetryIntervalMillis: 5000,
+					RetryOnTimeout:         true,
+					MaxConcurrentRuns:      1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						ExistingClusterID: "abc",
+						SparkJarTask: &SparkJarTask{
+							MainClassName: "com.labs.BarMain",
+						},
+						Libraries: []Library{
+							{
+								Jar: "dbfs://ff/gg/hh.jar",
+							},
+							{
+								Jar: "dbfs://aa/bb/cc.jar",
+							},
+						},
+						Name:                   "Featurizer",
+						MaxRetries:             3,
+						MinRetryIntervalMillis: 5000,
+						RetryOnTimeout:         true,
+						MaxConcurrentRuns:      1,
+						Schedule: &CronSchedule{
+							QuartzCronExpression: "0 15 22 ? * *",
+							TimezoneID:           "America/Los_Angeles",
+							PauseStatus:          "PAUSED",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `existing_cluster_id = "abc"
+		max_concurrent_runs = 1
+		max_retries = 3
+		min_retry_interval_millis = 5000
+		name = "Featurizer"
+		retry_on_timeout = true
+		schedule {
+			quartz_cron_expression = "0 15 22 ? * *"
+			timezone_id = "America/Los_Angeles"
+			pause_status = "PAUSED"
+		}
+		spark_jar_task {
+			main_class_name = "com.labs.BarMain"
+		}
+		library {
+			jar = "dbfs://aa/bb/cc.jar"
+		}
+		library {
+			jar = "dbfs://ff/gg/hh.jar"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+}
+
+func TestResourceJobCreate_MultiTask(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							SparkJarTask: &SparkJarTask{
+								MainClassName: "com.labs.BarMain",
+							},
+							Libraries: []Library{
+								{
+									Jar: "dbfs://aa/bb/cc.jar",
+								},
+							},
+						},
+						{
+							TaskKey: "b",
+							DependsOn: []TaskDependency{
+								{
+									TaskKey: "a",
+								},
+							},
+							NewCluster: &Cluster{
+								NumWorkers:   1,
+								SparkVersion: "7.3.x-scala2.12",
+								NodeTypeID:   "Standard_DS3_v2",
+							},
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							MaxRetries: 2,
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "a",
+								ExistingClusterID: "abc",
+								SparkJarTask: &SparkJarTask{
+									MainClassName: "com.labs.BarMain",
+								},
+								Libraries: []Library{
+									{
+										Jar: "dbfs://aa/bb/cc.jar",
+									},
+								},
+							},
+							{
+								TaskKey: "b",
+								DependsOn: []TaskDependency{
+									{
+										TaskKey: "a",
+									},
+								},
+								NewCluster: &Cluster{
+									NumWorkers:   1,
+									SparkVersion: "7.3.x-scala2.12",
+									NodeTypeID:   "Standard_DS3_v2",
+								},
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								MaxRetries: 2,
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			spark_jar_task {
+				main_class_name = "com.labs.BarMain"
+			}
+			library {
+				jar = "dbfs://aa/bb/cc.jar"
+			}
+		}
+
+		task {
+			task_key = "b"
+			depends_on {
+				task_key = "a"
+			}
+			new_cluster {
+				num_workers   = 1
+				spark_version = "7.3.x-scala2.12"
+				node_type_id  = "Standard_DS3_v2"
+			}
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			max_retries = 2
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, JobFormatMultiTask, d.Get("format"))
+	assert.Equal(t, 2, d.Get("task.#"))
+	assert.Equal(t, "a", d.Get("task.1.depends_on.0.task_key"))
+	assert.Equal(t, "/Stuff", d.Get("task.1.notebook_task.0.notebook_path"))
+}
+
+func TestResourceJobCreate_MultiTaskConflict(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [existing_cluster_id] Conflicting configuration arguments")
+}
+
+func TestResourceJobCreate_JobClusters(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					JobClusters: []JobCluster{
+						{
+							JobClusterKey: "shared",
+							NewCluster: &Cluster{
+								NumWorkers:   2,
+								SparkVersion: "7.3.x-scala2.12",
+								NodeTypeID:   "Standard_DS3_v2",
+							},
+						},
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:       "a",
+							JobClusterKey: "shared",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Ingest",
+							},
+						},
+						{
+							TaskKey:       "b",
+							JobClusterKey: "shared",
+							DependsOn: []TaskDependency{
+								{
+									TaskKey: "a",
+								},
+							},
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Featurize",
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						JobClusters: []JobCluster{
+							{
+								JobClusterKey: "shared",
+								NewCluster: &Cluster{
+									NumWorkers:   2,
+									SparkVersion: "7.3.x-scala2.12",
+									NodeTypeID:   "Standard_DS3_v2",
+									CustomTags: map[string]string{
+										"DatabricksInstanceGroupId": "-123",
+									},
+								},
+							},
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:       "a",
+								JobClusterKey: "shared",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Ingest",
+								},
+							},
+							{
+								TaskKey:       "b",
+								JobClusterKey: "shared",
+								DependsOn: []TaskDependency{
+									{
+										TaskKey: "a",
+									},
+								},
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Featurize",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+
+		job_cluster {
+			job_cluster_key = "shared"
+			new_cluster {
+				num_workers   = 2
+				spark_version = "7.3.x-scala2.12"
+				node_type_id  = "Standard_DS3_v2"
+			}
+		}
+
+		task {
+			task_key = "a"
+			job_cluster_key = "shared"
+			notebook_task {
+				notebook_path = "/Ingest"
+			}
+		}
+
+		task {
+			task_key = "b"
+			job_cluster_key = "shared"
+			depends_on {
+				task_key = "a"
+			}
+			notebook_task {
+				notebook_path = "/Featurize"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "shared", d.Get("job_cluster.0.job_cluster_key"))
+	assert.Len(t, d.Get("job_cluster.0.new_cluster.0.custom_tags"), 0)
+	assert.Equal(t, "shared", d.Get("task.1.job_cluster_key"))
+}
+
+func TestResourceJobCreate_UnknownJobCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		job_cluster {
+			job_cluster_key = "shared"
+			new_cluster {
+				num_workers   = 2
+				spark_version = "7.3.x-scala2.12"
+				node_type_id  = "Standard_DS3_v2"
+			}
+		}
+		task {
+			task_key = "a"
+			job_cluster_key = "other"
+			notebook_task {
+				notebook_path = "/Ingest"
+			}
+		}`,
+	}.ExpectError(t, "task a refers to unknown job_cluster_key: other")
+}
+
+func TestResourceJobCreate_GitSource(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "notebooks/Featurize",
+					},
+					GitSource: &GitSource{
+						URL:      "https://github.com/acme/data",
+						Provider: "gitHub",
+						Branch:   "main",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "notebooks/Featurize",
+						},
+						GitSource: &GitSource{
+							URL:      "https://github.com/acme/data",
+							Provider: "gitHub",
+							Branch:   "main",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		git_source {
+			url = "https://github.com/acme/data"
+			branch = "main"
+		}
+		notebook_task {
+			notebook_path = "notebooks/Featurize"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "gitHub", d.Get("git_source.0.provider"))
+}
+
+func TestResourceJobCreate_GitSourceUnknownProvider(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		git_source {
+			url = "https://git.acme.com/data"
+			tag = "v1.0.0"
+		}
+		notebook_task {
+			notebook_path = "notebooks/Featurize"
+		}`,
+	}.ExpectError(t, "git_source.provider must be specified for https://git.acme.com/data")
+}
+
+func TestResourceJobCreate_GitSourceConflictingReferences(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		git_source {
+			url = "https://github.com/acme/data"
+			branch = "main"
+			tag = "v1.0.0"
+		}
+		notebook_task {
+			notebook_path = "notebooks/Featurize"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [git_source.#.branch] Invalid combination of arguments. "+
+		"[git_source.#.commit] Invalid combination of arguments. [git_source.#.tag] Invalid combination of arguments")
+}
+
+func TestGitProviderFromURL(t *testing.T) {
+	for u, provider := range map[string]string{
+		"https://github.com/acme/data":                                 "gitHub",
+		"https://gitlab.com/acme/data":                                 "gitLab",
+		"https://bitbucket.org/acme/data.git":                          "bitbucketCloud",
+		"https://dev.azure.com/acme/data/_git/data":                    "azureDevOpsServices",
+		"https://acme.visualstudio.com/data/_git/data":                 "azureDevOpsServices",
+		"https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/data": "awsCodeCommit",
+	} {
+		js := JobSettings{GitSource: &GitSource{URL: u}}
+		require.NoError(t, js.withGitProvider(), u)
+		assert.Equal(t, provider, js.GitSource.Provider, u)
+	}
+}
+
+func TestResourceJobCreate_DbtTask(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Models",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					GitSource: &GitSource{
+						URL:      "https://github.com/acme/dbt",
+						Provider: "gitHub",
+						Branch:   "main",
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "models",
+							ExistingClusterID: "abc",
+							DbtTask: &DbtTask{
+								Commands:         []string{"dbt deps", "dbt run"},
+								ProjectDirectory: "analytics",
+								Schema:           "marts",
+								WarehouseID:      "def",
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Models",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						GitSource: &GitSource{
+							URL:      "https://github.com/acme/dbt",
+							Provider: "gitHub",
+							Branch:   "main",
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "models",
+								ExistingClusterID: "abc",
+								DbtTask: &DbtTask{
+									Commands:         []string{"dbt deps", "dbt run"},
+									ProjectDirectory: "analytics",
+									Schema:           "marts",
+									WarehouseID:      "def",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Models"
+		max_concurrent_runs = 1
+		git_source {
+			url = "https://github.com/acme/dbt"
+			branch = "main"
+		}
+		task {
+			task_key = "models"
+			existing_cluster_id = "abc"
+			dbt_task {
+				commands = ["dbt deps", "dbt run"]
+				project_directory = "analytics"
+				schema = "marts"
+				warehouse_id = "def"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "dbt run", d.Get("task.0.dbt_task.0.commands.1"))
+	assert.Equal(t, "def", d.Get("task.0.dbt_task.0.warehouse_id"))
+}
+
+func TestResourceJobCreate_DbtTaskWrongCommand(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "models"
+			existing_cluster_id = "abc"
+			dbt_task {
+				commands = ["run"]
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [task.#.dbt_task.#.commands.#] "+
+		"invalid value for task.0.dbt_task.0.commands.0 (dbt commands must start with `dbt`)")
+}
+
+func TestResourceJobCreate_PipelineTask(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Refresh",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "dlt",
+							PipelineTask: &PipelineTask{
+								PipelineID:  "123",
+								FullRefresh: true,
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Refresh",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "dlt",
+								PipelineTask: &PipelineTask{
+									PipelineID:  "123",
+									FullRefresh: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Refresh"
+		max_concurrent_runs = 1
+		task {
+			task_key = "dlt"
+			pipeline_task {
+				pipeline_id = "123"
+				full_refresh = true
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "123", d.Get("task.0.pipeline_task.0.pipeline_id"))
+	assert.Equal(t, true, d.Get("task.0.pipeline_task.0.full_refresh"))
+}
+
+func TestResourceJobCreate_PipelineTaskWithCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "dlt"
+			existing_cluster_id = "abc"
+			pipeline_task {
+				pipeline_id = "123"
+			}
+		}`,
+	}.ExpectError(t, "task dlt: pipeline_task runs on clusters of the pipeline, "+
+		"so new_cluster, existing_cluster_id or job_cluster_key cannot be set")
+}
+
+func TestResourceJobCreate_SparkSubmitTask(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Submit",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "submit",
+							NewCluster: &Cluster{
+								NumWorkers:   1,
+								SparkVersion: "7.3.x-scala2.12",
+								NodeTypeID:   "Standard_DS3_v2",
+							},
+							SparkSubmitTask: &SparkSubmitTask{
+								Parameters: []string{"--class", "com.acme.Main", "dbfs:/jars/main.jar"},
+							},
+						},
+						{
+							TaskKey:           "jar",
+							ExistingClusterID: "abc",
+							SparkJarTask: &SparkJarTask{
+								MainClassName: "com.acme.Report",
+								Parameters:    []string{"--full"},
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Submit",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "submit",
+								NewCluster: &Cluster{
+									NumWorkers:   1,
+									SparkVersion: "7.3.x-scala2.12",
+									NodeTypeID:   "Standard_DS3_v2",
+								},
+								SparkSubmitTask: &SparkSubmitTask{
+									Parameters: []string{"--class", "com.acme.Main", "dbfs:/jars/main.jar"},
+								},
+							},
+							{
+								TaskKey:           "jar",
+								ExistingClusterID: "abc",
+								SparkJarTask: &SparkJarTask{
+									MainClassName: "com.acme.Report",
+									Parameters:    []string{"--full"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Submit"
+		max_concurrent_runs = 1
+		task {
+			task_key = "submit"
+			new_cluster {
+				num_workers   = 1
+				spark_version = "7.3.x-scala2.12"
+				node_type_id  = "Standard_DS3_v2"
+			}
+			spark_submit_task {
+				parameters = ["--class", "com.acme.Main", "dbfs:/jars/main.jar"]
+			}
+		}
+		task {
+			task_key = "jar"
+			existing_cluster_id = "abc"
+			spark_jar_task {
+				main_class_name = "com.acme.Report"
+				parameters = ["--full"]
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, 3, d.Get("task.0.spark_submit_task.0.parameters.#"))
+	assert.Equal(t, "--full", d.Get("task.1.spark_jar_task.0.parameters.0"))
+}
+
+func TestResourceJobCreate_SparkSubmitTaskInstancePool(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		new_cluster {
+			num_workers   = 1
+			spark_version = "7.3.x-scala2.12"
+			instance_pool_id = "pool"
+		}
+		spark_submit_task {
+			parameters = ["--class", "com.acme.Main", "dbfs:/jars/main.jar"]
+		}`,
+	}.ExpectError(t, "spark_submit_task cannot run on clusters from instance pools")
+}
+
+func TestResourceJobCreate_SparkSubmitTaskPolicy(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		job_cluster {
+			job_cluster_key = "shared"
+			new_cluster {
+				num_workers   = 1
+				spark_version = "7.3.x-scala2.12"
+				node_type_id  = "Standard_DS3_v2"
+				policy_id     = "def"
+			}
+		}
+		task {
+			task_key = "submit"
+			job_cluster_key = "shared"
+			spark_submit_task {
+				parameters = ["--class", "com.acme.Main", "dbfs:/jars/main.jar"]
+			}
+		}`,
+	}.ExpectError(t, "task submit: spark_submit_task cannot run on clusters with policy_id")
+}
+
+func TestResourceJobCreate_SparkSubmitTaskExistingCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		spark_submit_task {
+			parameters = ["--class", "com.acme.Main", "dbfs:/jars/main.jar"]
+		}`,
+	}.ExpectError(t, "spark_submit_task can only run on new_cluster")
+}
+
+func TestResourceJobCreate_TaskOnJobCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/clusters/get?cluster_id=job-123-run-1",
+				Response: ClusterInfo{
+					ClusterID:     "job-123-run-1",
+					ClusterSource: "JOB",
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			existing_cluster_id = "job-123-run-1"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "task.0.existing_cluster_id: job-123-run-1 is a job cluster, "+
+		"use new_cluster or job_cluster_key instead")
+}
+
+func TestResourceJobCreate_TaskOnInteractiveClusterWithLibraries(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							Libraries: []Library{
+								{
+									Whl: "dbfs:/FileStore/wheels/featurizer.whl",
+								},
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "a",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								Libraries: []Library{
+									{
+										Whl: "dbfs:/FileStore/wheels/featurizer.whl",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			library {
+				whl = "dbfs:/FileStore/wheels/featurizer.whl"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "abc", d.Get("task.0.existing_cluster_id"))
+	assert.Equal(t, 1, d.Get("task.0.library.#"))
+}
+
+func TestResourceJobCreate_ServerlessEnvironment(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Serverless",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Environments: []JobEnvironment{
+						{
+							EnvironmentKey: "default",
+							Spec: &EnvironmentSpec{
+								Client:       "1",
+								Dependencies: []string{"pandas==2.1.4"},
+							},
+						},
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:        "a",
+							EnvironmentKey: "default",
+							SparkPythonTask: &SparkPythonTask{
+								PythonFile: "/Workspace/featurize.py",
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Serverless",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Environments: []JobEnvironment{
+							{
+								EnvironmentKey: "default",
+								Spec: &EnvironmentSpec{
+									Client:       "1",
+									Dependencies: []string{"pandas==2.1.4"},
+								},
+							},
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:        "a",
+								EnvironmentKey: "default",
+								SparkPythonTask: &SparkPythonTask{
+									PythonFile: "/Workspace/featurize.py",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Serverless"
+		max_concurrent_runs = 1
+		environment {
+			environment_key = "default"
+			spec {
+				client = "1"
+				dependencies = ["pandas==2.1.4"]
+			}
+		}
+		task {
+			task_key = "a"
+			environment_key = "default"
+			spark_python_task {
+				python_file = "/Workspace/featurize.py"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "default", d.Get("task.0.environment_key"))
+	assert.Equal(t, "pandas==2.1.4", d.Get("environment.0.spec.0.dependencies.0"))
+}
+
+func TestResourceJobCreate_UnknownEnvironment(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			environment_key = "missing"
+			spark_python_task {
+				python_file = "/Workspace/featurize.py"
+			}
+		}`,
+	}.ExpectError(t, "task a refers to unknown environment_key: missing")
+}
+
+func TestResourceJobCreate_EnvironmentWithCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		environment {
+			environment_key = "default"
+			spec {
+				client = "1"
+			}
+		}
+		task {
+			task_key = "a"
+			environment_key = "default"
+			job_cluster_key = "shared"
+			spark_python_task {
+				python_file = "/Workspace/featurize.py"
+			}
+		}`,
+	}.ExpectError(t, "task a: environment_key runs on serverless compute, "+
+		"so new_cluster, existing_cluster_id or job_cluster_key cannot be set")
+}
+
+func TestResourceJobCreate_EnvironmentWithNotebook(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		environment {
+			environment_key = "default"
+			spec {
+				client = "1"
+			}
+		}
+		task {
+			task_key = "a"
+			environment_key = "default"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+		}`,
+	}.ExpectError(t, "task a: environment_key can only be used with spark_python_task")
+}
+
+func TestResourceJobCreate_SQLTask(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Reports",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey: "query",
+							SQLTask: &SQLTask{
+								Query: &SQLQueryTask{
+									QueryID: "123",
+								},
+								WarehouseID: "def",
+								Parameters: map[string]string{
+									"day": "today",
+								},
+							},
+						},
+						{
+							TaskKey: "dashboard",
+							DependsOn: []TaskDependency{
+								{
+									TaskKey: "query",
+								},
+							},
+							SQLTask: &SQLTask{
+								Dashboard: &SQLDashboardTask{
+									DashboardID: "456",
+								},
+								WarehouseID: "def",
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Reports",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey: "query",
+								SQLTask: &SQLTask{
+									Query: &SQLQueryTask{
+										QueryID: "123",
+									},
+									WarehouseID: "def",
+									Parameters: map[string]string{
+										"day": "today",
+									},
+								},
+							},
+							{
+								TaskKey: "dashboard",
+								DependsOn: []TaskDependency{
+									{
+										TaskKey: "query",
+									},
+								},
+								SQLTask: &SQLTask{
+									Dashboard: &SQLDashboardTask{
+										DashboardID: "456",
+									},
+									WarehouseID: "def",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Reports"
+		max_concurrent_runs = 1
+		task {
+			task_key = "query"
+			sql_task {
+				query {
+					query_id = "123"
+				}
+				warehouse_id = "def"
+				parameters = {
+					day = "today"
+				}
+			}
+		}
+		task {
+			task_key = "dashboard"
+			depends_on {
+				task_key = "query"
+			}
+			sql_task {
+				dashboard {
+					dashboard_id = "456"
+				}
+				warehouse_id = "def"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "123", d.Get("task.0.sql_task.0.query.0.query_id"))
+	assert.Equal(t, "456", d.Get("task.1.sql_task.0.dashboard.0.dashboard_id"))
+}
+
+func TestResourceJobCreate_SQLTaskAmbiguous(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "query"
+			sql_task {
+				query {
+					query_id = "123"
+				}
+				alert {
+					alert_id = "456"
+				}
+				warehouse_id = "def"
+			}
+		}`,
+	}.ExpectError(t, "task query: sql_task must have exactly one of query, dashboard, alert or file blocks")
+}
+
+func TestResourceJobCreate_SQLTaskWithCluster(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "query"
+			existing_cluster_id = "abc"
+			sql_task {
+				file {
+					path = "queries/daily.sql"
+				}
+				warehouse_id = "def"
+			}
+		}`,
+	}.ExpectError(t, "task query: sql_task runs on SQL warehouse, "+
+		"so new_cluster, existing_cluster_id or job_cluster_key cannot be set")
+}
+
+func TestResourceJobCreate_Continuous(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Stream",
+					MaxConcurrentRuns: 1,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Stream",
+					},
+					Continuous: &ContinuousConf{
+						PauseStatus: "UNPAUSED",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Stream",
+						MaxConcurrentRuns: 1,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stream",
+						},
+						Continuous: &ContinuousConf{
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Stream"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stream"
+		}
+		continuous {}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "UNPAUSED", d.Get("continuous.0.pause_status"))
+}
+
+func TestResourceJobCreate_ContinuousWithSchedule(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stream"
+		}
+		schedule {
+			quartz_cron_expression = "0 15 22 ? * *"
+			timezone_id = "America/Los_Angeles"
+		}
+		continuous {
+			pause_status = "PAUSED"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [continuous] Conflicting configuration arguments")
+}
+
+func TestResourceJobCreate_FileArrivalTrigger(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Ingest",
+					MaxConcurrentRuns: 1,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Ingest",
+					},
+					Trigger: &Trigger{
+						FileArrival: &FileArrivalTrigger{
+							URL:                           "s3://landing/events/",
+							MinTimeBetweenTriggersSeconds: 300,
+						},
+						PauseStatus: "UNPAUSED",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Ingest",
+						MaxConcurrentRuns: 1,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Ingest",
+						},
+						Trigger: &Trigger{
+							FileArrival: &FileArrivalTrigger{
+								URL:                           "s3://landing/events/",
+								MinTimeBetweenTriggersSeconds: 300,
+							},
+							PauseStatus: "UNPAUSED",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Ingest"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Ingest"
+		}
+		trigger {
+			file_arrival {
+				url = "s3://landing/events/"
+				min_time_between_triggers_seconds = 300
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "s3://landing/events/", d.Get("trigger.0.file_arrival.0.url"))
+	assert.Equal(t, "UNPAUSED", d.Get("trigger.0.pause_status"))
+}
+
+func TestResourceJobCreate_TableUpdateTrigger(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Aggregate",
+					MaxConcurrentRuns: 1,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Aggregate",
+					},
+					Trigger: &Trigger{
+						TableUpdate: &TableUpdateTrigger{
+							TableNames: []string{"main.sales.orders", "main.sales.returns"},
+							Condition:  "ALL_UPDATED",
+						},
+						PauseStatus: "PAUSED",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Aggregate",
+						MaxConcurrentRuns: 1,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Aggregate",
+						},
+						Trigger: &Trigger{
+							TableUpdate: &TableUpdateTrigger{
+								TableNames: []string{"main.sales.orders", "main.sales.returns"},
+								Condition:  "ALL_UPDATED",
+							},
+							PauseStatus: "PAUSED",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Aggregate"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Aggregate"
+		}
+		trigger {
+			pause_status = "PAUSED"
+			table_update {
+				table_names = ["main.sales.orders", "main.sales.returns"]
+				condition = "ALL_UPDATED"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "main.sales.returns", d.Get("trigger.0.table_update.0.table_names.1"))
+}
+
+func TestResourceJobCreate_TriggerWithSchedule(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Ingest"
+		}
+		schedule {
+			quartz_cron_expression = "0 15 22 ? * *"
+			timezone_id = "America/Los_Angeles"
+		}
+		trigger {
+			file_arrival {
+				url = "s3://landing/events/"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [trigger] Conflicting configuration arguments")
+}
+
+func TestResourceJobCreate_TriggerTooFrequent(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Ingest"
+		}
+		trigger {
+			file_arrival {
+				url = "s3://landing/events/"
+				wait_after_last_change_seconds = 10
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [trigger.#.file_arrival.#.wait_after_last_change_seconds] "+
+		"expected trigger.0.file_arrival.0.wait_after_last_change_seconds to be at least (60), got 10")
+}
+
+func TestResourceJobCreate_Queue(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Backfill",
+					MaxConcurrentRuns: 10,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Backfill",
+					},
+					Queue: &QueueSettings{
+						Enabled: true,
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Backfill",
+						MaxConcurrentRuns: 10,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Backfill",
+						},
+						Queue: &QueueSettings{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Backfill"
+		max_concurrent_runs = 10
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Backfill"
+		}
+		queue {
+			enabled = true
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, true, d.Get("queue.0.enabled"))
+	assert.Equal(t, 10, d.Get("max_concurrent_runs"))
+}
+
+func TestResourceJobCreate_TooManyConcurrentRuns(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		max_concurrent_runs = 1001
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Backfill"
+		}`,
+	}.ExpectError(t, "invalid config supplied. [max_concurrent_runs] expected max_concurrent_runs to be in the range (1 - 1000), got 1001")
+}
+
+func TestResourceJobCreate_Notifications(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					EmailNotifications: &JobEmailNotifications{
+						OnFailure:             []string{"oncall@example.com"},
+						NoAlertForSkippedRuns: true,
+					},
+					NotificationSettings: &JobNotificationSettings{
+						NoAlertForCanceledRuns: true,
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							MaxRetries: 3,
+							EmailNotifications: &JobEmailNotifications{
+								OnSuccess: []string{"owner@example.com"},
+							},
+							NotificationSettings: &TaskNotificationSettings{
+								AlertOnLastAttempt: true,
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						EmailNotifications: &JobEmailNotifications{
+							OnFailure:             []string{"oncall@example.com"},
+							NoAlertForSkippedRuns: true,
+						},
+						NotificationSettings: &JobNotificationSettings{
+							NoAlertForCanceledRuns: true,
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "a",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								MaxRetries: 3,
+								EmailNotifications: &JobEmailNotifications{
+									OnSuccess: []string{"owner@example.com"},
+								},
+								NotificationSettings: &TaskNotificationSettings{
+									AlertOnLastAttempt: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		email_notifications {
+			on_failure = ["oncall@example.com"]
+			no_alert_for_skipped_runs = true
+		}
+		notification_settings {
+			no_alert_for_canceled_runs = true
+		}
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			max_retries = 3
+			email_notifications {
+				on_success = ["owner@example.com"]
+			}
+			notification_settings {
+				alert_on_last_attempt = true
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, true, d.Get("notification_settings.0.no_alert_for_canceled_runs"))
+	assert.Equal(t, "owner@example.com", d.Get("task.0.email_notifications.0.on_success.0"))
+	assert.Equal(t, true, d.Get("task.0.notification_settings.0.alert_on_last_attempt"))
+}
+
+func TestResourceJobCreate_WebhookNotifications(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Stuff",
+					},
+					WebhookNotifications: &WebhookNotifications{
+						OnFailure: []Webhook{
+							{
+								ID: "slack",
+							},
+							{
+								ID: "pagerduty",
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						WebhookNotifications: &WebhookNotifications{
+							OnFailure: []Webhook{
+								{
+									ID: "slack",
+								},
+								{
+									ID: "pagerduty",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stuff"
+		}
+		webhook_notifications {
+			on_failure {
+				id = "slack"
+			}
+			on_failure {
+				id = "pagerduty"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "pagerduty", d.Get("webhook_notifications.0.on_failure.1.id"))
+}
+
+func TestResourceJobCreate_Health(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					Format:            JobFormatMultiTask,
+					Health: &JobHealth{
+						Rules: []JobHealthRule{
+							{
+								Metric: "RUN_DURATION_SECONDS",
+								Op:     "GREATER_THAN",
+								Value:  7200,
+							},
+						},
+					},
+					EmailNotifications: &JobEmailNotifications{
+						OnDurationWarningThresholdExceeded: []string{"oncall@example.com"},
+					},
+					Tasks: []JobTaskSettings{
+						{
+							TaskKey:           "a",
+							ExistingClusterID: "abc",
+							NotebookTask: &NotebookTask{
+								NotebookPath: "/Stuff",
+							},
+							Health: &JobHealth{
+								Rules: []JobHealthRule{
+									{
+										Metric: "RUN_DURATION_SECONDS",
+										Op:     "GREATER_THAN",
+										Value:  600,
+									},
+								},
+							},
+						},
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+						Health: &JobHealth{
+							Rules: []JobHealthRule{
+								{
+									Metric: "RUN_DURATION_SECONDS",
+									Op:     "GREATER_THAN",
+									Value:  7200,
+								},
+							},
+						},
+						EmailNotifications: &JobEmailNotifications{
+							OnDurationWarningThresholdExceeded: []string{"oncall@example.com"},
+						},
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "a",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								Health: &JobHealth{
+									Rules: []JobHealthRule{
+										{
+											Metric: "RUN_DURATION_SECONDS",
+											Op:     "GREATER_THAN",
+											Value:  600,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		health {
+			rules {
+				metric = "RUN_DURATION_SECONDS"
+				op = "GREATER_THAN"
+				value = 7200
+			}
+		}
+		email_notifications {
+			on_duration_warning_threshold_exceeded = ["oncall@example.com"]
+		}
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			health {
+				rules {
+					metric = "RUN_DURATION_SECONDS"
+					op = "GREATER_THAN"
+					value = 600
+				}
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, 7200, d.Get("health.0.rules.0.value"))
+	assert.Equal(t, 600, d.Get("task.0.health.0.rules.0.value"))
+}
+
+func TestResourceJobCreate_HealthWrongMetric(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stuff"
+		}
+		health {
+			rules {
+				metric = "RUN_QUEUED_SECONDS"
+				op = "GREATER_THAN"
+				value = 10
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [health.#.rules.#.metric] expected health.0.rules.0.metric "+
+		"to be one of [RUN_DURATION_SECONDS], got RUN_QUEUED_SECONDS")
+}
+
+func TestResourceJobRead_MultiTask(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:   "Featurizer",
+						Format: JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "a",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "789",
+		State: map[string]interface{}{
+			"format": JobFormatMultiTask,
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "a", d.Get("task.0.task_key"))
+	assert.Equal(t, "abc", d.Get("task.0.existing_cluster_id"))
+}
+
+func TestResourceJobRead_ImportMultiTask(t *testing.T) {
+	settings := &JobSettings{
+		Name:              "Featurizer",
+		MaxConcurrentRuns: 1,
+		Format:            JobFormatMultiTask,
+		Schedule: &CronSchedule{
+			QuartzCronExpression: "0 0 1 * * ?",
+			TimezoneID:           "UTC",
+			PauseStatus:          "UNPAUSED",
+		},
+		EmailNotifications: &JobEmailNotifications{
+			OnFailure: []string{"oncall@example.com"},
+		},
+		JobClusters: []JobCluster{
+			{
+				JobClusterKey: "shared",
+				NewCluster: &Cluster{
+					SparkVersion: "7.3.x-scala2.12",
+					NodeTypeID:   "i3.xlarge",
+					NumWorkers:   2,
+					CustomTags: map[string]string{
+						"team": "data",
+					},
+				},
+			},
+		},
+		Tasks: []JobTaskSettings{
+			{
+				TaskKey:       "ingest",
+				JobClusterKey: "shared",
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Shared/Ingest",
+				},
+			},
+			{
+				TaskKey:       "featurize",
+				JobClusterKey: "shared",
+				DependsOn: []TaskDependency{
+					{
+						TaskKey: "ingest",
+					},
+				},
+				NotebookTask: &NotebookTask{
+					NotebookPath: "/Shared/Featurize",
+				},
+			},
+		},
+	}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				// Jobs API 2.0 doesn't return tasks and job clusters
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Format:            JobFormatMultiTask,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID:    789,
+					Settings: settings,
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "789",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, JobFormatMultiTask, d.Get("format"))
+	assert.Equal(t, 2, d.Get("task.#"))
+	assert.Equal(t, "ingest", d.Get("task.1.depends_on.0.task_key"))
+	assert.Equal(t, "shared", d.Get("job_cluster.0.job_cluster_key"))
+	assert.Equal(t, 2, d.Get("job_cluster.0.new_cluster.0.num_workers"))
+	assert.Equal(t, "0 0 1 * * ?", d.Get("schedule.0.quartz_cron_expression"))
+	assert.Equal(t, "oncall@example.com", d.Get("email_notifications.0.on_failure.0"))
+	state := d.State().Attributes
+	assert.Equal(t, "false", state["always_running"])
+	assert.Equal(t, "data", state["job_cluster.0.new_cluster.0.custom_tags.team"])
+}
+
+func TestResourceJobRead_TaskRetries(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:   "Featurizer",
+						Format: JobFormatMultiTask,
+						Tasks: []JobTaskSettings{
+							{
+								TaskKey:           "a",
+								ExistingClusterID: "abc",
+								NotebookTask: &NotebookTask{
+									NotebookPath: "/Stuff",
+								},
+								TimeoutSeconds:         3600,
+								MaxRetries:             -1,
+								MinRetryIntervalMillis: 60000,
+								RetryOnTimeout:         true,
+							},
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "789",
+		State: map[string]interface{}{
+			"format": JobFormatMultiTask,
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, 3600, d.Get("task.0.timeout_seconds"))
+	assert.Equal(t, -1, d.Get("task.0.max_retries"))
+	assert.Equal(t, 60000, d.Get("task.0.min_retry_interval_millis"))
+	assert.Equal(t, true, d.Get("task.0.retry_on_timeout"))
+}
+
+func TestResourceJobCreate_Tags(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/create",
+				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+					ExistingClusterID: "abc",
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Stuff",
+					},
+					Tags: map[string]string{
+						"cost-center": "42",
+						"team":        "data",
+					},
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						Format: "SINGLE_TASK",
+						Tags: map[string]string{
+							"cost-center": "42",
+							"team":        "data",
+						},
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stuff"
+		}
+		tags = {
+			"cost-center" = "42"
+			"team" = "data"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "data", d.Get("tags.team"))
+}
+
+func TestResourceJobCreate_EditModeLocked(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
 			{
 				Method:   "POST",
-				Resource: "/api/2.0/jobs/create",
+				Resource: "/api/2.1/jobs/create",
 				ExpectedRequest: JobSettings{
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
 					ExistingClusterID: "abc",
-					SparkJarTask: &SparkJarTask{
-						MainClassName: "com.labs.BarMain",
-					},
-					Libraries: []Library{
-						{
-							Jar: "dbfs://ff/gg/hh.jar",
-						},
-						{
-							Jar: "dbfs://aa/bb/cc.jar",
-						},
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Stuff",
 					},
-					Schedule: &CronSchedule{
-						QuartzCronExpression: "0 15 22 ? * *",
-						TimezoneID:           "America/Los_Angeles",
-						PauseStatus:          "PAUSED",
+					EditMode: "UI_LOCKED",
+					Deployment: &JobDeployment{
+						Kind: "BUNDLE",
 					},
-					Name:                   "Featurizer",
-					MaxRetries:             3,
-					MinRetryIntervalMillis: 5000,
-					RetryOnTimeout:         true,
-					MaxConcurrentRuns:      1,
 				},
 				Response: Job{
 					JobID: 789,
@@ -49,31 +2222,20 @@ func TestResourceJobCreate(t *testing.T) {
 			},
 			{
 				Method:   "GET",
-				Resource: "/api/2.0/jobs/get?job_id=789",
+				Resource: "/api/2.1/jobs/get?job_id=789",
 				Response: Job{
 					JobID: 789,
 					Settings: &JobSettings{
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
 						ExistingClusterID: "abc",
-						SparkJarTask: &SparkJarTask{
-							MainClassName: "com.labs.BarMain",
-						},
-						Libraries: []Library{
-							{
-								Jar: "dbfs://ff/gg/hh.jar",
-							},
-							{
-								Jar: "dbfs://aa/bb/cc.jar",
-							},
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
 						},
-						Name:                   "Featurizer",
-						MaxRetries:             3,
-						MinRetryIntervalMillis: 5000,
-						RetryOnTimeout:         true,
-						MaxConcurrentRuns:      1,
-						Schedule: &CronSchedule{
-							QuartzCronExpression: "0 15 22 ? * *",
-							TimezoneID:           "America/Los_Angeles",
-							PauseStatus:          "PAUSED",
+						Format:   "SINGLE_TASK",
+						EditMode: "UI_LOCKED",
+						Deployment: &JobDeployment{
+							Kind: "BUNDLE",
 						},
 					},
 				},
@@ -81,34 +2243,98 @@ func TestResourceJobCreate(t *testing.T) {
 		},
 		Create:   true,
 		Resource: ResourceJob(),
-		HCL: `existing_cluster_id = "abc"
-		max_concurrent_runs = 1
-		max_retries = 3
-		min_retry_interval_millis = 5000
+		HCL: `
 		name = "Featurizer"
-		retry_on_timeout = true
-		schedule {
-			quartz_cron_expression = "0 15 22 ? * *"
-			timezone_id = "America/Los_Angeles"
-			pause_status = "PAUSED"
-		}
-		spark_jar_task {
-			main_class_name = "com.labs.BarMain"
-		}
-		library {
-			jar = "dbfs://aa/bb/cc.jar"
+		max_concurrent_runs = 1
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stuff"
 		}
-		library {
-			jar = "dbfs://ff/gg/hh.jar"
+		edit_mode = "UI_LOCKED"
+		deployment {
+			kind = "BUNDLE"
 		}`,
 	}.Apply(t)
 	assert.NoError(t, err, err)
-	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "UI_LOCKED", d.Get("edit_mode"))
+	assert.Equal(t, "BUNDLE", d.Get("deployment.0.kind"))
+}
+
+func TestResourceJobCreate_WrongEditMode(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stuff"
+		}
+		edit_mode = "LOCKED"`,
+	}.ExpectError(t, "invalid config supplied. [edit_mode] expected edit_mode "+
+		"to be one of [UI_LOCKED EDITABLE], got LOCKED")
+}
+
+func TestResourceJobRead_TagsChangedInUI(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.1/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						Name:              "Featurizer",
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						Format: "SINGLE_TASK",
+						Tags: map[string]string{
+							"team": "bi",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "789",
+		State: map[string]interface{}{
+			"format": "SINGLE_TASK",
+			"tags": map[string]interface{}{
+				"team":        "data",
+				"cost-center": "42",
+			},
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, map[string]interface{}{
+		"team": "bi",
+	}, d.Get("tags"))
+}
+
+func TestResourceJobCreate_TaskNegativeTimeout(t *testing.T) {
+	qa.ResourceFixture{
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `
+		task {
+			task_key = "a"
+			existing_cluster_id = "abc"
+			notebook_task {
+				notebook_path = "/Stuff"
+			}
+			timeout_seconds = -5
+		}`,
+	}.ExpectError(t, "invalid config supplied. [task.#.timeout_seconds] "+
+		"expected task.0.timeout_seconds to be at least (0), got -5")
 }
 
 func TestResourceJobCreate_AlwaysRunning(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/jobs/create",
@@ -359,6 +2585,7 @@ func TestResourceJobCreateSingleNode_Fail(t *testing.T) {
 func TestResourceJobCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/jobs/create",
@@ -494,6 +2721,7 @@ func TestResourceJobRead_Error(t *testing.T) {
 func TestResourceJobUpdate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/jobs/reset",
@@ -505,19 +2733,171 @@ func TestResourceJobUpdate(t *testing.T) {
 							MainClassName: "com.labs.BarMain",
 							Parameters:    []string{"--cleanup", "full"},
 						},
-						Libraries: []Library{
-							{
-								Jar: "dbfs://ff/gg/hh.jar",
-							},
-							{
-								Jar: "dbfs://aa/bb/cc.jar",
-							},
+						Libraries: []Library{
+							{
+								Jar: "dbfs://ff/gg/hh.jar",
+							},
+							{
+								Jar: "dbfs://aa/bb/cc.jar",
+							},
+						},
+						Name:                   "Featurizer New",
+						MaxRetries:             3,
+						MinRetryIntervalMillis: 5000,
+						RetryOnTimeout:         true,
+						MaxConcurrentRuns:      1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						ExistingClusterID: "abc",
+						SparkJarTask: &SparkJarTask{
+							MainClassName: "com.labs.BarMain",
+							Parameters:    []string{"--cleanup", "full"},
+						},
+						Libraries: []Library{
+							{
+								Jar: "dbfs://ff/gg/hh.jar",
+							},
+							{
+								Jar: "dbfs://aa/bb/cc.jar",
+							},
+						},
+						Name:                   "Featurizer New",
+						MaxRetries:             3,
+						MinRetryIntervalMillis: 5000,
+						RetryOnTimeout:         true,
+						MaxConcurrentRuns:      1,
+					},
+				},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		HCL: `existing_cluster_id = "abc"
+		max_concurrent_runs = 1
+		max_retries = 3
+		min_retry_interval_millis = 5000
+		name = "Featurizer New"
+		retry_on_timeout = true
+
+		spark_jar_task {
+			main_class_name = "com.labs.BarMain"
+			parameters = ["--cleanup", "full"]
+		}
+		library {
+			jar = "dbfs://aa/bb/cc.jar"
+		}
+		library {
+			jar = "dbfs://ff/gg/hh.jar"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id(), "Id should be the same as in reading")
+	assert.Equal(t, "Featurizer New", d.Get("name"))
+}
+
+func TestResourceJobUpdate_PauseSchedule(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Featurizer",
+						},
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Schedule: &CronSchedule{
+							QuartzCronExpression: "0 15 22 ? * *",
+							TimezoneID:           "America/Los_Angeles",
+							PauseStatus:          "PAUSED",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Featurizer",
+						},
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+						Schedule: &CronSchedule{
+							QuartzCronExpression: "0 15 22 ? * *",
+							TimezoneID:           "America/Los_Angeles",
+							PauseStatus:          "PAUSED",
+						},
+					},
+				},
+			},
+		},
+		ID:       "789",
+		Update:   true,
+		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"existing_cluster_id":               "abc",
+			"name":                              "Featurizer",
+			"max_concurrent_runs":               "1",
+			"notebook_task.#":                   "1",
+			"notebook_task.0.notebook_path":     "/Featurizer",
+			"schedule.#":                        "1",
+			"schedule.0.quartz_cron_expression": "0 15 22 ? * *",
+			"schedule.0.timezone_id":            "America/Los_Angeles",
+			"schedule.0.pause_status":           "UNPAUSED",
+		},
+		HCL: `existing_cluster_id = "abc"
+		name = "Featurizer"
+		max_concurrent_runs = 1
+		notebook_task {
+			notebook_path = "/Featurizer"
+		}
+		schedule {
+			quartz_cron_expression = "0 15 22 ? * *"
+			timezone_id = "America/Los_Angeles"
+			pause_status = "PAUSED"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id(), "Id should be the same as in reading")
+	assert.Equal(t, "PAUSED", d.Get("schedule.0.pause_status"))
+}
+
+func TestResourceJobUpdate_KeepsExternallyPausedSchedule(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 789,
+					NewSettings: &JobSettings{
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Featurizer",
+						},
+						Name:              "Featurizer New",
+						MaxConcurrentRuns: 1,
+						Schedule: &CronSchedule{
+							QuartzCronExpression: "0 15 22 ? * *",
+							TimezoneID:           "America/Los_Angeles",
+							PauseStatus:          "PAUSED",
 						},
-						Name:                   "Featurizer New",
-						MaxRetries:             3,
-						MinRetryIntervalMillis: 5000,
-						RetryOnTimeout:         true,
-						MaxConcurrentRuns:      1,
 					},
 				},
 			},
@@ -528,23 +2908,16 @@ func TestResourceJobUpdate(t *testing.T) {
 					JobID: 789,
 					Settings: &JobSettings{
 						ExistingClusterID: "abc",
-						SparkJarTask: &SparkJarTask{
-							MainClassName: "com.labs.BarMain",
-							Parameters:    []string{"--cleanup", "full"},
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Featurizer",
 						},
-						Libraries: []Library{
-							{
-								Jar: "dbfs://ff/gg/hh.jar",
-							},
-							{
-								Jar: "dbfs://aa/bb/cc.jar",
-							},
+						Name:              "Featurizer New",
+						MaxConcurrentRuns: 1,
+						Schedule: &CronSchedule{
+							QuartzCronExpression: "0 15 22 ? * *",
+							TimezoneID:           "America/Los_Angeles",
+							PauseStatus:          "PAUSED",
 						},
-						Name:                   "Featurizer New",
-						MaxRetries:             3,
-						MinRetryIntervalMillis: 5000,
-						RetryOnTimeout:         true,
-						MaxConcurrentRuns:      1,
 					},
 				},
 			},
@@ -552,27 +2925,30 @@ func TestResourceJobUpdate(t *testing.T) {
 		ID:       "789",
 		Update:   true,
 		Resource: ResourceJob(),
+		InstanceState: map[string]string{
+			"existing_cluster_id":               "abc",
+			"name":                              "Featurizer",
+			"max_concurrent_runs":               "1",
+			"notebook_task.#":                   "1",
+			"notebook_task.0.notebook_path":     "/Featurizer",
+			"schedule.#":                        "1",
+			"schedule.0.quartz_cron_expression": "0 15 22 ? * *",
+			"schedule.0.timezone_id":            "America/Los_Angeles",
+			"schedule.0.pause_status":           "PAUSED",
+		},
 		HCL: `existing_cluster_id = "abc"
-		max_concurrent_runs = 1
-		max_retries = 3
-		min_retry_interval_millis = 5000
 		name = "Featurizer New"
-		retry_on_timeout = true
-
-		spark_jar_task {
-			main_class_name = "com.labs.BarMain"
-			parameters = ["--cleanup", "full"]
-		}
-		library {
-			jar = "dbfs://aa/bb/cc.jar"
+		max_concurrent_runs = 1
+		notebook_task {
+			notebook_path = "/Featurizer"
 		}
-		library {
-			jar = "dbfs://ff/gg/hh.jar"
+		schedule {
+			quartz_cron_expression = "0 15 22 ? * *"
+			timezone_id = "America/Los_Angeles"
 		}`,
 	}.Apply(t)
 	assert.NoError(t, err, err)
-	assert.Equal(t, "789", d.Id(), "Id should be the same as in reading")
-	assert.Equal(t, "Featurizer New", d.Get("name"))
+	assert.Equal(t, "PAUSED", d.Get("schedule.0.pause_status"))
 }
 
 func TestResourceJobUpdate_Restart(t *testing.T) {
@@ -803,9 +3179,72 @@ func TestJobRestarts(t *testing.T) {
 	})
 }
 
+func TestJobRestart_LargeJobID(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/runs/list?active_only=true&job_id=1099511627776",
+			Response: JobRunsList{
+				Runs: []JobRun{},
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/jobs/run-now",
+			ExpectedRequest: RunParameters{
+				JobID: 1099511627776,
+			},
+			Response: JobRun{
+				RunID: 234,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/runs/get?run_id=234",
+			Response: JobRun{
+				State: RunState{
+					LifeCycleState: "RUNNING",
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewJobsAPI(ctx, client).Restart("1099511627776", 500*time.Millisecond)
+		assert.NoError(t, err)
+	})
+}
+
+func TestJobStart_TerminatedRun(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/jobs/run-now",
+			ExpectedRequest: RunParameters{
+				JobID: 123,
+			},
+			Response: JobRun{
+				RunID: 234,
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/jobs/runs/get?run_id=234",
+			Response: JobRun{
+				State: RunState{
+					LifeCycleState: "TERMINATED",
+					StateMessage:   "Notebook not found",
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		err := NewJobsAPI(ctx, client).Start(123, time.Minute)
+		assert.EqualError(t, err, "run 234 is TERMINATED before reaching RUNNING: Notebook not found")
+	})
+}
+
 func TestResourceJobUpdate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
 			{
 				Method:   "POST",
 				Resource: "/api/2.0/jobs/reset",
@@ -860,6 +3299,38 @@ func TestResourceJobDelete(t *testing.T) {
 	assert.Equal(t, "789", d.Id())
 }
 
+func TestResourceJobDelete_Continuous(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/runs/cancel-all",
+				ExpectedRequest: map[string]int{
+					"job_id": 789,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/delete",
+				ExpectedRequest: map[string]int{
+					"job_id": 789,
+				},
+			},
+		},
+		ID:       "789",
+		Delete:   true,
+		Resource: ResourceJob(),
+		HCL: `existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stream"
+		}
+		continuous {}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+}
+
 func TestResourceJobUpdate_FailNumWorkersZero(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		ID:       "789",
@@ -936,3 +3407,216 @@ func TestJobsAPIRunsList(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, l.Runs, 1)
 }
+
+func TestResourceJobCreateWithDockerImage(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/create",
+				ExpectedRequest: JobSettings{
+					NewCluster: &Cluster{
+						NumWorkers:   1,
+						SparkVersion: "7.3.x-scala2.12",
+						NodeTypeID:   "Standard_DS3_v2",
+						DockerImage: &DockerImage{
+							URL: "acme.azurecr.io/sample:latest",
+							BasicAuth: &DockerBasicAuth{
+								Username: "acme",
+								Password: "secret",
+							},
+						},
+					},
+					NotebookTask: &NotebookTask{
+						NotebookPath: "/Featurizer",
+					},
+					Name:              "Featurizer",
+					MaxConcurrentRuns: 1,
+				},
+				Response: Job{
+					JobID: 789,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=789",
+				Response: Job{
+					JobID: 789,
+					Settings: &JobSettings{
+						NewCluster: &Cluster{
+							NumWorkers:   1,
+							SparkVersion: "7.3.x-scala2.12",
+							NodeTypeID:   "Standard_DS3_v2",
+							DockerImage: &DockerImage{
+								URL: "acme.azurecr.io/sample:latest",
+								BasicAuth: &DockerBasicAuth{
+									Username: "acme",
+									Password: "secret",
+								},
+							},
+						},
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Featurizer",
+						},
+						Name:              "Featurizer",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+		Create:   true,
+		Resource: ResourceJob(),
+		HCL: `new_cluster {
+			num_workers   = 1
+			spark_version = "7.3.x-scala2.12"
+			node_type_id  = "Standard_DS3_v2"
+			docker_image {
+				url = "acme.azurecr.io/sample:latest"
+				basic_auth {
+					username = "acme"
+					password = "secret"
+				}
+			}
+		}
+		max_concurrent_runs = 1
+		name = "Featurizer"
+		notebook_task {
+			notebook_path = "/Featurizer"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "789", d.Id())
+	assert.Equal(t, "acme.azurecr.io/sample:latest", d.Get("new_cluster.0.docker_image.0.url"))
+
+	p, err := common.SchemaPath(jobSchema, "new_cluster", "docker_image", "basic_auth", "password")
+	assert.NoError(t, err)
+	assert.True(t, p.Sensitive)
+}
+
+func TestResourceJobRead_LargeID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=1099511627776",
+				Response: Job{
+					JobID: 1099511627776,
+					Settings: &JobSettings{
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						Name:              "Large",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+		Resource: ResourceJob(),
+		Read:     true,
+		New:      true,
+		ID:       "1099511627776",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "Large", d.Get("name"))
+}
+
+func TestResourceJobUpdate_LargeID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/reset",
+				ExpectedRequest: UpdateJobRequest{
+					JobID: 1099511627776,
+					NewSettings: &JobSettings{
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						Name:              "Large",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/jobs/get?job_id=1099511627776",
+				Response: Job{
+					JobID: 1099511627776,
+					Settings: &JobSettings{
+						ExistingClusterID: "abc",
+						NotebookTask: &NotebookTask{
+							NotebookPath: "/Stuff",
+						},
+						Name:              "Large",
+						MaxConcurrentRuns: 1,
+					},
+				},
+			},
+		},
+		ID:       "1099511627776",
+		Update:   true,
+		Resource: ResourceJob(),
+		HCL: `existing_cluster_id = "abc"
+		max_concurrent_runs = 1
+		name = "Large"
+		notebook_task {
+			notebook_path = "/Stuff"
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "1099511627776", d.Id())
+}
+
+func TestResourceJobDelete_LargeID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/jobs/delete",
+				ExpectedRequest: map[string]int64{
+					"job_id": 1099511627776,
+				},
+			},
+		},
+		ID:       "1099511627776",
+		Delete:   true,
+		Resource: ResourceJob(),
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "1099511627776", d.Id())
+}
+
+func TestResourceJobDelete_ContinuousLargeID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			interactiveClusterFixture,
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/runs/cancel-all",
+				ExpectedRequest: map[string]int64{
+					"job_id": 1099511627776,
+				},
+			},
+			{
+				Method:   "POST",
+				Resource: "/api/2.1/jobs/delete",
+				ExpectedRequest: map[string]int64{
+					"job_id": 1099511627776,
+				},
+			},
+		},
+		ID:       "1099511627776",
+		Delete:   true,
+		Resource: ResourceJob(),
+		HCL: `existing_cluster_id = "abc"
+		notebook_task {
+			notebook_path = "/Stream"
+		}
+		continuous {}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "1099511627776", d.Id())
+}
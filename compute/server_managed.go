@@ -0,0 +1,69 @@
+package compute
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// serverManagedSparkConf are spark_conf keys, that Databricks adds to clusters on its own
+var serverManagedSparkConf = []string{
+	"spark.databricks.delta.preview.enabled",
+}
+
+// serverManagedTags are custom_tags, that Databricks adds to clusters and pools on its own
+var serverManagedTags = []string{
+	"DatabricksInstancePoolId",
+	"DatabricksInstancePoolCreatorId",
+	"DatabricksInstanceGroupId",
+}
+
+// withoutServerManaged removes keys, that are managed by Databricks, from the map received from API,
+// unless they are explicitly configured on the resource, so that they never show up in diff.
+// Configured map is expected in the form of `d.Get("spark_conf")`.
+func withoutServerManaged(received map[string]string, configured interface{},
+	managed []string) map[string]string {
+	if len(received) == 0 {
+		return received
+	}
+	configuredMap, _ := configured.(map[string]interface{})
+	filtered := map[string]string{}
+	for k, v := range received {
+		if _, isConfigured := configuredMap[k]; !isConfigured && isServerManaged(k, managed) {
+			log.Printf("[DEBUG] Ignoring server-managed %s=%s", k, v)
+			continue
+		}
+		filtered[k] = v
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+func isServerManaged(key string, managed []string) bool {
+	for _, m := range managed {
+		if key == m {
+			return true
+		}
+	}
+	return false
+}
+
+// sparkConfDiffSuppressFunc suppresses server-managed keys in spark_conf of clusters,
+// job clusters and pipeline clusters, which were persisted in state by earlier versions
+func sparkConfDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	isPossiblyLegacyConfig := strings.HasSuffix(k, "spark_conf.%") && old == "1" && new == "0"
+	isLegacyConfig := false
+	for _, m := range serverManagedSparkConf {
+		if strings.HasSuffix(k, "spark_conf."+m) && new == "" {
+			isLegacyConfig = true
+		}
+	}
+	if isPossiblyLegacyConfig || isLegacyConfig {
+		log.Printf("[DEBUG] Suppressing diff for k=%#v old=%#v new=%#v", k, old, new)
+		return true
+	}
+	return false
+}
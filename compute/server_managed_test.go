@@ -0,0 +1,34 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithoutServerManaged(t *testing.T) {
+	received := map[string]string{
+		"DatabricksInstancePoolId": "abc",
+		"Team":                     "marketing",
+	}
+	assert.Equal(t, map[string]string{"Team": "marketing"},
+		withoutServerManaged(received, map[string]interface{}{"Team": "marketing"}, serverManagedTags))
+	assert.Equal(t, received, withoutServerManaged(received, map[string]interface{}{
+		"DatabricksInstancePoolId": "abc",
+	}, serverManagedTags))
+	assert.Nil(t, withoutServerManaged(map[string]string{
+		"spark.databricks.delta.preview.enabled": "true",
+	}, nil, serverManagedSparkConf))
+	assert.Nil(t, withoutServerManaged(nil, nil, serverManagedSparkConf))
+}
+
+func TestSparkConfDiffSuppressFunc(t *testing.T) {
+	assert.True(t, sparkConfDiffSuppressFunc("spark_conf.%", "1", "0", nil))
+	assert.True(t, sparkConfDiffSuppressFunc("new_cluster.0.spark_conf.spark.databricks.delta.preview.enabled",
+		"true", "", nil))
+	assert.True(t, sparkConfDiffSuppressFunc("cluster.0.spark_conf.spark.databricks.delta.preview.enabled",
+		"true", "", nil))
+	assert.False(t, sparkConfDiffSuppressFunc("spark_conf.spark.databricks.delta.preview.enabled",
+		"true", "false", nil))
+	assert.False(t, sparkConfDiffSuppressFunc("spark_conf.spark.sql.shuffle.partitions", "8", "", nil))
+}
@@ -90,3 +90,54 @@ func TestDataSourceGroup(t *testing.T) {
 	assert.Equal(t, true, d.Get("allow_instance_pool_create"))
 	assert.Equal(t, true, d.Get("allow_cluster_create"))
 }
+
+func TestDataSourceGroup_ExactDisplayName(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%27admins%27",
+				Response: GroupList{
+					Resources: []ScimGroup{
+						{
+							DisplayName: "Admins",
+							ID:          "custom",
+						},
+						{
+							DisplayName: "admins",
+							ID:          "builtin",
+						},
+					},
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		State: map[string]interface{}{
+			"display_name": "admins",
+		},
+	}.Apply(t)
+	require.NoError(t, err)
+	assert.Equal(t, "builtin", d.Id())
+}
+
+func TestDataSourceGroup_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%27missing%27",
+				Response: GroupList{},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceGroup(),
+		ID:          ".",
+		State: map[string]interface{}{
+			"display_name": "missing",
+		},
+	}.ExpectError(t, "cannot find group: missing")
+}
@@ -38,17 +38,30 @@ func (a GroupsAPI) Read(groupID string) (group ScimGroup, err error) {
 	return
 }
 
-// Filter returns groups matching the filter
+// Filter returns groups matching the filter. All pages of results are fetched,
+// as SCIM API returns only the first page for workspaces with many groups.
 func (a GroupsAPI) Filter(filter string) (GroupList, error) {
 	var groups GroupList
 	req := map[string]string{}
 	if filter != "" {
 		req["filter"] = filter
 	}
-	err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Groups", req, &groups)
-	return groups, err
+	for {
+		var page GroupList
+		err := a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Groups", req, &page)
+		if err != nil {
+			return groups, err
+		}
+		groups.Resources = append(groups.Resources, page.Resources...)
+		if !hasNextPage(len(page.Resources), len(groups.Resources), page.TotalResults) {
+			groups.TotalResults = int32(len(groups.Resources))
+			return groups, nil
+		}
+		req["startIndex"] = fmt.Sprintf("%d", len(groups.Resources)+1)
+	}
 }
 
+// ReadByDisplayName resolves group, like built-in `admins` or `users`, by its display name
 func (a GroupsAPI) ReadByDisplayName(displayName string) (group ScimGroup, err error) {
 	groupList, err := a.Filter(fmt.Sprintf("displayName eq '%s'", displayName))
 	if err != nil {
@@ -58,6 +71,12 @@ func (a GroupsAPI) ReadByDisplayName(displayName string) (group ScimGroup, err e
 		err = fmt.Errorf("cannot find group: %s", displayName)
 		return
 	}
+	// SCIM filter is case-insensitive, so prefer the group with exactly the same name
+	for _, g := range groupList.Resources {
+		if g.DisplayName == displayName {
+			return g, nil
+		}
+	}
 	group = groupList.Resources[0]
 	return
 }
@@ -66,7 +85,7 @@ func (a GroupsAPI) Patch(groupID string, r patchRequest) error {
 	return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID), r, nil)
 }
 
-func (a GroupsAPI) UpdateNameAndEntitlements(groupID string, name string, e entitlements) error {
+func (a GroupsAPI) UpdateNameAndEntitlements(groupID, name, externalID string, e entitlements) error {
 	g, err := a.Read(groupID)
 	if err != nil {
 		return err
@@ -75,6 +94,7 @@ func (a GroupsAPI) UpdateNameAndEntitlements(groupID string, name string, e enti
 		fmt.Sprintf("/preview/scim/v2/Groups/%v", groupID),
 		ScimGroup{
 			DisplayName:  name,
+			ExternalID:   externalID,
 			Entitlements: e,
 			Groups:       g.Groups,
 			Roles:        g.Roles,
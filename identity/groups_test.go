@@ -73,3 +73,35 @@ func TestAccFilterGroup(t *testing.T) {
 	assert.NotNil(t, groupList)
 	assert.Len(t, groupList.Resources, 1)
 }
+
+func TestGroupsFilter_Pagination(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?",
+			Response: GroupList{
+				TotalResults: 2,
+				Resources: []ScimGroup{
+					{DisplayName: "admins"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?startIndex=2",
+			Response: GroupList{
+				TotalResults: 2,
+				StartIndex:   2,
+				Resources: []ScimGroup{
+					{DisplayName: "users"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		groups, err := NewGroupsAPI(ctx, client).Filter("")
+		require.NoError(t, err)
+		require.Len(t, groups.Resources, 2)
+		assert.Equal(t, "users", groups.Resources[1].DisplayName)
+		assert.Equal(t, int32(2), groups.TotalResults)
+	})
+}
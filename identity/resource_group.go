@@ -15,17 +15,23 @@ func ResourceGroup() *schema.Resource {
 			ForceNew: true,
 			Required: true,
 		},
+		"external_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
 		"url": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
 	}
 	addEntitlementsToSchema(&groupSchema)
-	return common.Resource{
+	return importByName(common.Resource{
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			groupName := d.Get("display_name").(string)
 			group, err := NewGroupsAPI(ctx, c).Create(ScimGroup{
 				DisplayName:  groupName,
+				ExternalID:   d.Get("external_id").(string),
 				Entitlements: readEntitlementsFromData(d),
 			})
 			if err != nil {
@@ -40,16 +46,21 @@ func ResourceGroup() *schema.Resource {
 				return err
 			}
 			d.Set("display_name", group.DisplayName)
+			d.Set("external_id", group.ExternalID)
 			d.Set("url", c.FormatURL("#setting/accounts/groups/", d.Id()))
 			return group.Entitlements.readIntoData(d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			groupName := d.Get("display_name").(string)
-			return NewGroupsAPI(ctx, c).UpdateNameAndEntitlements(d.Id(), groupName, readEntitlementsFromData(d))
+			return NewGroupsAPI(ctx, c).UpdateNameAndEntitlements(d.Id(), groupName,
+				d.Get("external_id").(string), readEntitlementsFromData(d))
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewGroupsAPI(ctx, c).Delete(d.Id())
 		},
 		Schema: groupSchema,
-	}.ToResource()
+	}.ToResource(), func(ctx context.Context, c *common.DatabricksClient, name string) (string, error) {
+		group, err := NewGroupsAPI(ctx, c).ReadByDisplayName(name)
+		return group.ID, err
+	})
 }
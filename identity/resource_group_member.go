@@ -3,15 +3,48 @@ package identity
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// checkMembershipCycle walks nested groups of the member and fails, if the group is one of them,
+// as SCIM API would otherwise make the group transitively a member of itself
+func checkMembershipCycle(groupsAPI GroupsAPI, groupID, memberID string) error {
+	visited := map[string]bool{}
+	queue := []string{memberID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == groupID {
+			return fmt.Errorf("adding %s to group %s would create membership cycle", memberID, groupID)
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		group, err := groupsAPI.Read(current)
+		if e, ok := err.(common.APIError); ok && e.IsMissing() {
+			// users and service principals are not groups
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, member := range group.Members {
+			if strings.HasPrefix(member.Ref, "Groups/") {
+				queue = append(queue, member.Value)
+			}
+		}
+	}
+	return nil
+}
+
 // ResourceGroupMember bind group with member
 func ResourceGroupMember() *schema.Resource {
-	return common.NewPairID("group_id", "member_id").BindResource(common.BindResource{
+	r := common.NewPairID("group_id", "member_id").BindResource(common.BindResource{
 		CreateContext: func(ctx context.Context, groupID, memberID string, c *common.DatabricksClient) error {
 			return NewGroupsAPI(ctx, c).Patch(groupID, scimPatchRequest("add", "members", memberID))
 		},
@@ -28,4 +61,14 @@ func ResourceGroupMember() *schema.Resource {
 				"remove", fmt.Sprintf(`members[value eq "%s"]`, memberID), ""))
 		},
 	})
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		groupID := d.Get("group_id").(string)
+		memberID := d.Get("member_id").(string)
+		if d.Id() != "" || groupID == "" || memberID == "" {
+			// new groups are not known during plan and cannot have members yet
+			return nil
+		}
+		return checkMembershipCycle(NewGroupsAPI(ctx, m), groupID, memberID)
+	}
+	return r
 }
@@ -12,6 +12,16 @@ import (
 func TestResourceGroupMemberCreate(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Groups/bcd",
+				ReuseRequest: true,
+				Response: common.APIErrorBody{
+					ScimDetail: "Group with id bcd not found.",
+					ScimStatus: "404",
+				},
+				Status: 404,
+			},
 			{
 				Method:          "PATCH",
 				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
@@ -49,6 +59,16 @@ func TestResourceGroupMemberCreate(t *testing.T) {
 func TestResourceGroupMemberCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Groups/bcd",
+				ReuseRequest: true,
+				Response: common.APIErrorBody{
+					ScimDetail: "Group with id bcd not found.",
+					ScimStatus: "404",
+				},
+				Status: 404,
+			},
 			{
 				Method:   "PATCH",
 				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
@@ -197,3 +217,112 @@ func TestResourceGroupMemberDelete_Error(t *testing.T) {
 	qa.AssertErrorStartsWith(t, err, "Internal error happened")
 	assert.Equal(t, "abc|bcd", d.Id())
 }
+
+func TestResourceGroupMemberCreate_Cycle(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Groups/bcd",
+				ReuseRequest: true,
+				Response: ScimGroup{
+					ID: "bcd",
+					Members: []ComplexValue{
+						{
+							Value: "user",
+							Ref:   "Users/user",
+						},
+						{
+							Value: "cde",
+							Ref:   "Groups/cde",
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Groups/cde",
+				ReuseRequest: true,
+				Response: ScimGroup{
+					ID: "cde",
+					Members: []ComplexValue{
+						{
+							Value: "abc",
+							Ref:   "Groups/abc",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGroupMember(),
+		State: map[string]interface{}{
+			"group_id":  "abc",
+			"member_id": "bcd",
+		},
+		Create: true,
+	}.ExpectError(t, "adding bcd to group abc would create membership cycle")
+}
+
+func TestResourceGroupMemberCreate_Self(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceGroupMember(),
+		State: map[string]interface{}{
+			"group_id":  "abc",
+			"member_id": "abc",
+		},
+		Create: true,
+	}.ExpectError(t, "adding abc to group abc would create membership cycle")
+}
+
+func TestResourceGroupMemberCreate_NestedGroup(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Groups/bcd",
+				ReuseRequest: true,
+				Response: ScimGroup{
+					ID: "bcd",
+					Members: []ComplexValue{
+						{
+							Value: "cde",
+							Ref:   "Groups/cde",
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				Resource:     "/api/2.0/preview/scim/v2/Groups/cde",
+				ReuseRequest: true,
+				Response: ScimGroup{
+					ID: "cde",
+				},
+			},
+			{
+				Method:          "PATCH",
+				Resource:        "/api/2.0/preview/scim/v2/Groups/abc",
+				ExpectedRequest: scimPatchRequest("add", "members", "bcd"),
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				Response: ScimGroup{
+					ID: "abc",
+					Members: []ComplexValue{
+						{
+							Value: "bcd",
+							Ref:   "Groups/bcd",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceGroupMember(),
+		State: map[string]interface{}{
+			"group_id":  "abc",
+			"member_id": "bcd",
+		},
+		Create: true,
+	}.ApplyNoError(t)
+}
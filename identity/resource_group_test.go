@@ -1,12 +1,14 @@
 package identity
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
 	"github.com/databrickslabs/terraform-provider-databricks/qa"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceGroupCreate(t *testing.T) {
@@ -344,3 +346,73 @@ func TestResourceGroupDelete_Error(t *testing.T) {
 		ID:       "abc",
 	}.ExpectError(t, "Internal error happened")
 }
+
+func TestResourceGroupCreate_ExternalID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Groups",
+				ExpectedRequest: ScimGroup{
+					Schemas:     []URN{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+					DisplayName: "Data Scientists",
+					ExternalID:  "aad-group-id",
+				},
+				Response: ScimGroup{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Groups/abc",
+				Response: ScimGroup{
+					DisplayName: "Data Scientists",
+					ExternalID:  "aad-group-id",
+					ID:          "abc",
+				},
+			},
+		},
+		Resource: ResourceGroup(),
+		HCL: `
+		display_name = "Data Scientists"
+		external_id  = "aad-group-id"
+		`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "aad-group-id", d.Get("external_id"))
+}
+
+func TestResourceGroupImportByDisplayName(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups?filter=displayName%20eq%20%27Data%20Scientists%27",
+			Response: GroupList{
+				Resources: []ScimGroup{
+					{
+						DisplayName: "Data Scientists",
+						ID:          "123",
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Groups/123",
+			Response: ScimGroup{
+				DisplayName: "Data Scientists",
+				ID:          "123",
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceGroup()
+		d := r.TestResourceData()
+		d.SetId("Data Scientists")
+		imported, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, "123", imported[0].Id())
+		assert.Equal(t, "Data Scientists", imported[0].Get("display_name"))
+	})
+}
@@ -20,6 +20,7 @@ import (
 // InstanceProfileInfo contains the ARN for aws instance profiles
 type InstanceProfileInfo struct {
 	InstanceProfileArn string `json:"instance_profile_arn,omitempty"`
+	IamRoleArn         string `json:"iam_role_arn,omitempty"`
 }
 
 // InstanceProfileList ...
@@ -43,23 +44,31 @@ type InstanceProfilesAPI struct {
 
 // Create creates an instance profile record on Databricks
 func (a InstanceProfilesAPI) Create(instanceProfileARN string) error {
-	return a.client.Post(a.context, "/instance-profiles/add", map[string]interface{}{
-		"instance_profile_arn": instanceProfileARN,
-		"skip_validation":      false,
-	}, nil)
+	return a.Add(InstanceProfileInfo{InstanceProfileArn: instanceProfileARN}, false)
+}
+
+// Add registers an instance profile with optional IAM role on Databricks. Validation
+// has to be skipped for meta instance profiles, that cannot launch clusters on their own
+func (a InstanceProfilesAPI) Add(profile InstanceProfileInfo, skipValidation bool) error {
+	request := map[string]interface{}{
+		"instance_profile_arn": profile.InstanceProfileArn,
+		"skip_validation":      skipValidation,
+	}
+	if profile.IamRoleArn != "" {
+		request["iam_role_arn"] = profile.IamRoleArn
+	}
+	return a.client.Post(a.context, "/instance-profiles/add", request, nil)
 }
 
-// Read returns the ARN back if it exists on the Databricks workspace
-func (a InstanceProfilesAPI) Read(instanceProfileARN string) (string, error) {
-	var response string
+// Read returns the instance profile back if it exists on the Databricks workspace
+func (a InstanceProfilesAPI) Read(instanceProfileARN string) (response InstanceProfileInfo, err error) {
 	instanceProfiles, err := a.List()
 	if err != nil {
 		return response, err
 	}
 	for _, profile := range instanceProfiles {
 		if profile.InstanceProfileArn == instanceProfileARN {
-			response = profile.InstanceProfileArn
-			return response, nil
+			return profile, nil
 		}
 	}
 	return response, common.APIError{
@@ -146,20 +155,38 @@ func ResourceInstanceProfile() *schema.Resource {
 
 				ValidateDiagFunc: ValidInstanceProfile,
 			},
+			"iam_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"skip_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 		},
 		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			profile, err := NewInstanceProfilesAPI(ctx, c).Read(d.Id())
 			if err != nil {
 				return err
 			}
-			return d.Set("instance_profile_arn", profile)
+			if err = d.Set("iam_role_arn", profile.IamRoleArn); err != nil {
+				return err
+			}
+			return d.Set("instance_profile_arn", profile.InstanceProfileArn)
 		},
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
-			ipa := d.Get("instance_profile_arn").(string)
-			if err := NewInstanceProfilesAPI(ctx, c).Create(ipa); err != nil {
+			profile := InstanceProfileInfo{
+				InstanceProfileArn: d.Get("instance_profile_arn").(string),
+				IamRoleArn:         d.Get("iam_role_arn").(string),
+			}
+			err := NewInstanceProfilesAPI(ctx, c).Add(profile, d.Get("skip_validation").(bool))
+			if err != nil {
 				return err
 			}
-			d.SetId(ipa)
+			d.SetId(profile.InstanceProfileArn)
 			return nil
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
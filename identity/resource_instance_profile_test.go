@@ -43,6 +43,43 @@ func TestResourceInstanceProfileCreate(t *testing.T) {
 	assert.Equal(t, "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile", d.Id())
 }
 
+func TestResourceInstanceProfileCreate_SkipValidation(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/instance-profiles/add",
+				ExpectedRequest: map[string]interface{}{
+					"instance_profile_arn": "arn:aws:iam::999999999999:instance-profile/meta",
+					"iam_role_arn":         "arn:aws:iam::999999999999:role/meta",
+					"skip_validation":      true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/instance-profiles/list",
+				Response: InstanceProfileList{
+					InstanceProfiles: []InstanceProfileInfo{
+						{
+							InstanceProfileArn: "arn:aws:iam::999999999999:instance-profile/meta",
+							IamRoleArn:         "arn:aws:iam::999999999999:role/meta",
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceInstanceProfile(),
+		HCL: `
+		instance_profile_arn = "arn:aws:iam::999999999999:instance-profile/meta"
+		iam_role_arn = "arn:aws:iam::999999999999:role/meta"
+		skip_validation = true`,
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "arn:aws:iam::999999999999:instance-profile/meta", d.Id())
+	assert.Equal(t, "arn:aws:iam::999999999999:role/meta", d.Get("iam_role_arn"))
+}
+
 func TestResourceInstanceProfileCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -195,9 +232,9 @@ func TestAwsAccInstanceProfiles(t *testing.T) {
 			assert.NoError(t, err, err)
 		}()
 
-		arnSearch, err := instanceProfilesAPI.Read(arn)
+		profile, err := instanceProfilesAPI.Read(arn)
 		assert.NoError(t, err, err)
-		assert.True(t, len(arnSearch) > 0)
+		assert.Equal(t, arn, profile.InstanceProfileArn)
 		return true
 	})
 }
@@ -29,6 +29,44 @@ func (a ServicePrincipalsAPI) Create(rsp ScimUser) (sp ScimUser, err error) {
 	return sp, err
 }
 
+// filter returns service principals matching the filter, following all pages of results
+func (a ServicePrincipalsAPI) filter(filter string) (sps []ScimUser, err error) {
+	req := map[string]string{
+		"filter": filter,
+	}
+	for {
+		var page UserList
+		err = a.client.Scim(a.context, "GET", "/preview/scim/v2/ServicePrincipals", req, &page)
+		if err != nil {
+			return
+		}
+		sps = append(sps, page.Resources...)
+		if !hasNextPage(len(page.Resources), len(sps), page.TotalResults) {
+			return
+		}
+		req["startIndex"] = fmt.Sprintf("%d", len(sps)+1)
+	}
+}
+
+// adopt takes over existing service principal with the same application id and replaces its information
+func (a ServicePrincipalsAPI) adopt(rsp ScimUser) (sp ScimUser, err error) {
+	if rsp.ApplicationID == "" {
+		err = fmt.Errorf("application_id is required to adopt existing service principal")
+		return
+	}
+	sps, err := a.filter(fmt.Sprintf("applicationId eq '%s'", rsp.ApplicationID))
+	if err != nil {
+		return
+	}
+	if len(sps) == 0 {
+		err = fmt.Errorf("cannot find service principal %s to adopt", rsp.ApplicationID)
+		return
+	}
+	sp = sps[0]
+	err = a.Update(sp.ID, rsp)
+	return
+}
+
 func (a ServicePrincipalsAPI) read(servicePrincipalID string) (sp ScimUser, err error) {
 	servicePrincipalPath := fmt.Sprintf("/preview/scim/v2/ServicePrincipals/%v", servicePrincipalID)
 	err = a.client.Scim(a.context, "GET", servicePrincipalPath, nil, &sp)
@@ -45,6 +83,7 @@ func (a ServicePrincipalsAPI) Update(servicePrincipalID string, updateRequest Sc
 		updateRequest.Schemas = []URN{ServicePrincipalSchema}
 	}
 	updateRequest.Groups = servicePrincipal.Groups
+	updateRequest.Roles = servicePrincipal.Roles
 	return a.client.Scim(a.context, "PUT",
 		fmt.Sprintf("/preview/scim/v2/ServicePrincipals/%v", servicePrincipalID),
 		updateRequest, nil)
@@ -62,6 +101,8 @@ func ResourceServicePrincipal() *schema.Resource {
 		ApplicationID string `json:"application_id,omitempty" tf:"computed"`
 		DisplayName   string `json:"display_name,omitempty" tf:"computed"`
 		Active        bool   `json:"active,omitempty"`
+		ExternalID    string `json:"external_id,omitempty" tf:"computed"`
+		Force         bool   `json:"force,omitempty"`
 	}
 	servicePrincipalSchema := common.StructToSchema(entity{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -79,10 +120,11 @@ func ResourceServicePrincipal() *schema.Resource {
 			ApplicationID: u.ApplicationID,
 			DisplayName:   u.DisplayName,
 			Active:        u.Active,
+			ExternalID:    u.ExternalID,
 			Entitlements:  readEntitlementsFromData(d),
 		}, nil
 	}
-	return common.Resource{
+	return importByName(common.Resource{
 		Schema: servicePrincipalSchema,
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, c interface{}) error {
 			var sp entity
@@ -106,7 +148,11 @@ func ResourceServicePrincipal() *schema.Resource {
 			if c.IsAws() && sp.ApplicationID != "" {
 				return fmt.Errorf("application_id is not allowed for service principals in Databricks on AWS")
 			}
-			servicePrincipal, err := NewServicePrincipalsAPI(ctx, c).Create(sp)
+			spAPI := NewServicePrincipalsAPI(ctx, c)
+			servicePrincipal, err := spAPI.Create(sp)
+			if isAlreadyExists(err) && d.Get("force").(bool) {
+				servicePrincipal, err = spAPI.adopt(sp)
+			}
 			if err != nil {
 				return err
 			}
@@ -122,6 +168,8 @@ func ResourceServicePrincipal() *schema.Resource {
 			if err != nil {
 				return err
 			}
+			// StructToData skips false values, so deactivation has to be read explicitly
+			d.Set("active", sp.Active)
 			return sp.Entitlements.readIntoData(d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -134,5 +182,14 @@ func ResourceServicePrincipal() *schema.Resource {
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			return NewServicePrincipalsAPI(ctx, c).Delete(d.Id())
 		},
-	}.ToResource()
+	}.ToResource(), func(ctx context.Context, c *common.DatabricksClient, name string) (string, error) {
+		sps, err := NewServicePrincipalsAPI(ctx, c).filter(fmt.Sprintf("applicationId eq '%s'", name))
+		if err != nil {
+			return "", err
+		}
+		if len(sps) == 0 {
+			return "", fmt.Errorf("cannot find service principal %s", name)
+		}
+		return sps[0].ID, nil
+	})
 }
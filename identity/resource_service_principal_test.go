@@ -402,3 +402,152 @@ func TestResourceServicePrincipalDelete_Error(t *testing.T) {
 	}.Apply(t)
 	require.Error(t, err, err)
 }
+
+func TestResourceServicePrincipalUpdate_DeactivateKeepsRoles(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				Response: ScimUser{
+					DisplayName: "Example Service Principal",
+					Active:      true,
+					ID:          "abc",
+					Roles: []ComplexValue{
+						{
+							Value: "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+						},
+					},
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				ExpectedRequest: map[string]interface{}{
+					"schemas":     []string{string(ServicePrincipalSchema)},
+					"displayName": "Example Service Principal",
+					"active":      false,
+					"roles": []map[string]string{
+						{
+							"value": "arn:aws:iam::999999999999:instance-profile/my-fake-instance-profile",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				Response: ScimUser{
+					DisplayName: "Example Service Principal",
+					Active:      false,
+					ID:          "abc",
+				},
+			},
+		},
+		Resource: ResourceServicePrincipal(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"display_name": "Example Service Principal",
+			"active":       "true",
+		},
+		HCL: `
+		display_name = "Example Service Principal"
+		active = false
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, false, d.Get("active"))
+}
+
+func TestResourceServicePrincipalRead_ExternalID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				Response: ScimUser{
+					ID:            "abc",
+					ApplicationID: "00000000-0000-0000-0000-000000000000",
+					DisplayName:   "Example Service Principal",
+					ExternalID:    "aad-object-id",
+					Active:        true,
+				},
+			},
+		},
+		Resource: ResourceServicePrincipal(),
+		HCL:      `display_name = "Example Service Principal"`,
+		New:      true,
+		Read:     true,
+		ID:       "abc",
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "aad-object-id", d.Get("external_id"))
+}
+
+func TestResourceServicePrincipalCreate_ForceAdoptsExisting(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals",
+				Response: common.APIErrorBody{
+					ScimDetail: "Service principal with application id 00000000-0000-0000-0000-000000000000 already exists.",
+					ScimStatus: "409",
+				},
+				Status: 409,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals?filter=applicationId%20eq%20%2700000000-0000-0000-0000-000000000000%27",
+				Response: UserList{
+					Resources: []ScimUser{
+						{
+							ID:            "abc",
+							ApplicationID: "00000000-0000-0000-0000-000000000000",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				Response: ScimUser{
+					ID:            "abc",
+					ApplicationID: "00000000-0000-0000-0000-000000000000",
+					Active:        true,
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				ExpectedRequest: ScimUser{
+					Schemas:       []URN{ServicePrincipalSchema},
+					ApplicationID: "00000000-0000-0000-0000-000000000000",
+					DisplayName:   "Example Service Principal",
+					Active:        true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/ServicePrincipals/abc",
+				Response: ScimUser{
+					ID:            "abc",
+					ApplicationID: "00000000-0000-0000-0000-000000000000",
+					DisplayName:   "Example Service Principal",
+					Active:        true,
+				},
+			},
+		},
+		Resource: ResourceServicePrincipal(),
+		Azure:    true,
+		Create:   true,
+		HCL: `
+		application_id = "00000000-0000-0000-0000-000000000000"
+		display_name   = "Example Service Principal"
+		force          = true
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+}
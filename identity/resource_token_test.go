@@ -239,6 +239,27 @@ func TestResourceTokenDelete_Error(t *testing.T) {
 	assert.Equal(t, "abc", d.Id())
 }
 
+func TestResourceTokenDelete_AlreadyExpired(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/token/delete",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Token abc does not exist",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceToken(),
+		Delete:   true,
+		ID:       "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+}
+
 func TestAccCreateToken(t *testing.T) {
 	if _, ok := os.LookupEnv("CLOUD_ENV"); !ok {
 		t.Skip("Acceptance tests skipped unless env 'CLOUD_ENV' is set")
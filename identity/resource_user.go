@@ -2,6 +2,7 @@ package identity
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 
@@ -11,9 +12,12 @@ import (
 // ResourceUser manages users within workspace
 func ResourceUser() *schema.Resource {
 	type entity struct {
-		UserName    string `json:"user_name"`
-		DisplayName string `json:"display_name,omitempty" tf:"computed"`
-		Active      bool   `json:"active,omitempty"`
+		UserName              string `json:"user_name"`
+		DisplayName           string `json:"display_name,omitempty" tf:"computed"`
+		Active                bool   `json:"active,omitempty"`
+		ExternalID            string `json:"external_id,omitempty" tf:"computed"`
+		Force                 bool   `json:"force,omitempty"`
+		DisableAsUserDeletion bool   `json:"disable_as_user_deletion,omitempty"`
 	}
 	userSchema := common.StructToSchema(entity{},
 		func(m map[string]*schema.Schema) map[string]*schema.Schema {
@@ -31,17 +35,22 @@ func ResourceUser() *schema.Resource {
 			UserName:     u.UserName,
 			DisplayName:  u.DisplayName,
 			Active:       u.Active,
+			ExternalID:   u.ExternalID,
 			Entitlements: readEntitlementsFromData(d),
 		}, nil
 	}
-	return common.Resource{
+	return importByName(common.Resource{
 		Schema: userSchema,
 		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
 			u, err := scimUserFromData(d)
 			if err != nil {
 				return err
 			}
-			user, err := NewUsersAPI(ctx, c).Create(u)
+			usersAPI := NewUsersAPI(ctx, c)
+			user, err := usersAPI.Create(u)
+			if isAlreadyExists(err) && d.Get("force").(bool) {
+				user, err = usersAPI.adopt(u)
+			}
 			if err != nil {
 				return err
 			}
@@ -56,6 +65,7 @@ func ResourceUser() *schema.Resource {
 			d.Set("user_name", user.UserName)
 			d.Set("display_name", user.DisplayName)
 			d.Set("active", user.Active)
+			d.Set("external_id", user.ExternalID)
 			return user.Entitlements.readIntoData(d)
 		},
 		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
@@ -66,7 +76,19 @@ func ResourceUser() *schema.Resource {
 			return NewUsersAPI(ctx, c).Update(d.Id(), u)
 		},
 		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			if d.Get("disable_as_user_deletion").(bool) {
+				return NewUsersAPI(ctx, c).Deactivate(d.Id())
+			}
 			return NewUsersAPI(ctx, c).Delete(d.Id())
 		},
-	}.ToResource()
+	}.ToResource(), func(ctx context.Context, c *common.DatabricksClient, name string) (string, error) {
+		users, err := NewUsersAPI(ctx, c).Filter(fmt.Sprintf("userName eq '%s'", name))
+		if err != nil {
+			return "", err
+		}
+		if len(users) == 0 {
+			return "", fmt.Errorf("cannot find user %s", name)
+		}
+		return users[0].ID, nil
+	})
 }
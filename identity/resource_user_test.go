@@ -1,6 +1,7 @@
 package identity
 
 import (
+	"context"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -366,3 +367,291 @@ func TestResourceUserDelete_Error(t *testing.T) {
 	}.Apply(t)
 	require.Error(t, err, err)
 }
+
+func TestResourceUserUpdate_Deactivate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				Response: ScimUser{
+					DisplayName: "Example user",
+					Active:      true,
+					UserName:    "me@example.com",
+					ID:          "abc",
+					Groups: []ComplexValue{
+						{
+							Display: "ds",
+							Value:   "9877",
+						},
+					},
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				ExpectedRequest: map[string]interface{}{
+					"schemas":     []string{string(UserSchema)},
+					"displayName": "Example user",
+					"userName":    "me@example.com",
+					"active":      false,
+					"groups": []map[string]string{
+						{
+							"display": "ds",
+							"value":   "9877",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				Response: ScimUser{
+					DisplayName: "Example user",
+					Active:      false,
+					UserName:    "me@example.com",
+					ID:          "abc",
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Update:   true,
+		ID:       "abc",
+		InstanceState: map[string]string{
+			"user_name":    "me@example.com",
+			"display_name": "Example user",
+			"active":       "true",
+		},
+		HCL: `
+		user_name    = "me@example.com"
+		display_name = "Example user"
+		active       = false
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, false, d.Get("active"))
+}
+
+func TestResourceUserCreate_ExternalID(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Users",
+				ExpectedRequest: ScimUser{
+					Active:     true,
+					UserName:   "me@example.com",
+					ExternalID: "f0b7e5b6-0000-0000-0000-7a5a3b0e1a11",
+					Schemas:    []URN{UserSchema},
+				},
+				Response: ScimUser{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				Response: ScimUser{
+					Active:     true,
+					UserName:   "me@example.com",
+					ExternalID: "f0b7e5b6-0000-0000-0000-7a5a3b0e1a11",
+					ID:         "abc",
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Create:   true,
+		HCL: `
+		user_name   = "me@example.com"
+		external_id = "f0b7e5b6-0000-0000-0000-7a5a3b0e1a11"
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "f0b7e5b6-0000-0000-0000-7a5a3b0e1a11", d.Get("external_id"))
+}
+
+func TestResourceUserCreate_ForceAdoptsExisting(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Users",
+				Response: common.APIErrorBody{
+					ScimDetail: "User with username me@example.com already exists.",
+					ScimStatus: "409",
+				},
+				Status: 409,
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27me%40example.com%27",
+				Response: UserList{
+					Resources: []ScimUser{
+						{
+							ID:       "abc",
+							UserName: "me@example.com",
+						},
+					},
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				Response: ScimUser{
+					ID:       "abc",
+					UserName: "me@example.com",
+					Active:   true,
+				},
+			},
+			{
+				Method:   "PUT",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				ExpectedRequest: ScimUser{
+					Schemas:     []URN{UserSchema},
+					UserName:    "me@example.com",
+					DisplayName: "Example user",
+					Active:      true,
+				},
+			},
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				Response: ScimUser{
+					ID:          "abc",
+					UserName:    "me@example.com",
+					DisplayName: "Example user",
+					Active:      true,
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Create:   true,
+		HCL: `
+		user_name    = "me@example.com"
+		display_name = "Example user"
+		force        = true
+		`,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "Example user", d.Get("display_name"))
+}
+
+func TestResourceUserCreate_ExistingWithoutForce(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "POST",
+				Resource: "/api/2.0/preview/scim/v2/Users",
+				Response: common.APIErrorBody{
+					ScimDetail: "User with username me@example.com already exists.",
+					ScimStatus: "409",
+				},
+				Status: 409,
+			},
+		},
+		Resource: ResourceUser(),
+		Create:   true,
+		HCL:      `user_name = "me@example.com"`,
+	}.ExpectError(t, "User with username me@example.com already exists.")
+}
+
+func TestResourceUserDelete_DisableAsUserDeletion(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "PATCH",
+				Resource: "/api/2.0/preview/scim/v2/Users/abc",
+				ExpectedRequest: map[string]interface{}{
+					"schemas": []string{string(PatchOp)},
+					"Operations": []map[string]interface{}{
+						{
+							"op":    "replace",
+							"path":  "active",
+							"value": false,
+						},
+					},
+				},
+			},
+		},
+		Resource: ResourceUser(),
+		Delete:   true,
+		ID:       "abc",
+		HCL: `
+		user_name                = "me@example.com"
+		disable_as_user_deletion = true
+		`,
+	}.ApplyNoError(t)
+}
+
+func TestResourceUserImportByUserName(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27me%40example.com%27",
+			Response: UserList{
+				Resources: []ScimUser{
+					{
+						ID:       "123",
+						UserName: "me@example.com",
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users/123",
+			Response: ScimUser{
+				ID:       "123",
+				UserName: "me@example.com",
+				Active:   true,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceUser()
+		d := r.TestResourceData()
+		d.SetId("me@example.com")
+		imported, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		require.Len(t, imported, 1)
+		assert.Equal(t, "123", imported[0].Id())
+		assert.Equal(t, "me@example.com", imported[0].Get("user_name"))
+	})
+}
+
+func TestResourceUserImportByID(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users/123",
+			Response: ScimUser{
+				ID:       "123",
+				UserName: "me@example.com",
+				Active:   true,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceUser()
+		d := r.TestResourceData()
+		d.SetId("123")
+		imported, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		assert.Equal(t, "me@example.com", imported[0].Get("user_name"))
+	})
+}
+
+func TestResourceUserImportByUserName_NotFound(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?filter=userName%20eq%20%27me%40example.com%27",
+			Response: UserList{},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		r := ResourceUser()
+		d := r.TestResourceData()
+		d.SetId("me@example.com")
+		_, err := r.Importer.StateContext(ctx, d, client)
+		assert.EqualError(t, err, "cannot find user me@example.com")
+	})
+}
@@ -1,6 +1,12 @@
 package identity
 
 import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -89,11 +95,46 @@ func addEntitlementsToSchema(s *map[string]*schema.Schema) {
 	}
 }
 
+// hasNextPage tells if SCIM list response has more results after the received page.
+// Responses without totalResults are treated as complete.
+func hasNextPage(pageSize, received int, totalResults int32) bool {
+	return pageSize > 0 && received < int(totalResults)
+}
+
+// isAlreadyExists tells if SCIM API refused to create principal, that already exists,
+// like users created on the first login to the workspace.
+func isAlreadyExists(err error) bool {
+	e, ok := err.(common.APIError)
+	return ok && (e.StatusCode == http.StatusConflict || strings.Contains(e.Message, "already exists"))
+}
+
+var scimIDRegex = regexp.MustCompile(`^\d+$`)
+
+// importByName allows to import principals by user name, group display name or
+// application id, because SCIM identifiers are not visible in the UI.
+func importByName(r *schema.Resource, findID func(ctx context.Context,
+	c *common.DatabricksClient, name string) (string, error)) *schema.Resource {
+	importRead := r.Importer.StateContext
+	r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData,
+		m interface{}) ([]*schema.ResourceData, error) {
+		if !scimIDRegex.MatchString(d.Id()) {
+			id, err := findID(ctx, m.(*common.DatabricksClient), d.Id())
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+		}
+		return importRead(ctx, d, m)
+	}
+	return r
+}
+
 // ScimGroup contains information about the SCIM group
 type ScimGroup struct {
 	ID           string         `json:"id,omitempty"`
 	Schemas      []URN          `json:"schemas,omitempty"`
 	DisplayName  string         `json:"displayName,omitempty"`
+	ExternalID   string         `json:"externalId,omitempty"`
 	Members      []ComplexValue `json:"members,omitempty"`
 	Groups       []ComplexValue `json:"groups,omitempty"`
 	Roles        []ComplexValue `json:"roles,omitempty"`
@@ -120,9 +161,10 @@ type ScimUser struct {
 	ID            string            `json:"id,omitempty"`
 	Emails        []email           `json:"emails,omitempty"`
 	DisplayName   string            `json:"displayName,omitempty" tf:"alias:display_name"`
-	Active        bool              `json:"active,omitempty"`
+	Active        bool              `json:"active"`
 	Schemas       []URN             `json:"schemas,omitempty"`
 	UserName      string            `json:"userName,omitempty" tf:"alias:user_name"`
+	ExternalID    string            `json:"externalId,omitempty" tf:"alias:external_id"`
 	ApplicationID string            `json:"applicationId,omitempty" tf:"alias:application_id"`
 	Groups        []ComplexValue    `json:"groups,omitempty"`
 	Name          map[string]string `json:"name,omitempty"`
@@ -31,18 +31,38 @@ func (a UsersAPI) Create(ru ScimUser) (user ScimUser, err error) {
 	return user, err
 }
 
-// Filter retrieves users by filter
+// Filter retrieves users by filter, following all pages of results
 func (a UsersAPI) Filter(filter string) (u []ScimUser, err error) {
-	var users UserList
 	req := map[string]string{}
 	if filter != "" {
 		req["filter"] = filter
 	}
-	err = a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Users", req, &users)
+	for {
+		var users UserList
+		err = a.client.Scim(a.context, http.MethodGet, "/preview/scim/v2/Users", req, &users)
+		if err != nil {
+			return
+		}
+		u = append(u, users.Resources...)
+		if !hasNextPage(len(users.Resources), len(u), users.TotalResults) {
+			return
+		}
+		req["startIndex"] = fmt.Sprintf("%d", len(u)+1)
+	}
+}
+
+// adopt takes over existing user with the same user name and replaces its information
+func (a UsersAPI) adopt(ru ScimUser) (user ScimUser, err error) {
+	users, err := a.Filter(fmt.Sprintf("userName eq '%s'", ru.UserName))
 	if err != nil {
 		return
 	}
-	u = users.Resources
+	if len(users) == 0 {
+		err = fmt.Errorf("cannot find %s to adopt", ru.UserName)
+		return
+	}
+	user = users[0]
+	err = a.Update(user.ID, ru)
 	return
 }
 
@@ -82,6 +102,20 @@ func (a UsersAPI) Patch(userID string, r patchRequest) error {
 	return a.client.Scim(a.context, http.MethodPatch, fmt.Sprintf("/preview/scim/v2/Users/%v", userID), r, nil)
 }
 
+// Deactivate sets user as inactive, keeping their notebooks and cluster ownership
+func (a UsersAPI) Deactivate(userID string) error {
+	return a.Patch(userID, patchRequest{
+		Schemas: []URN{PatchOp},
+		Operations: []patchOperation{
+			{
+				Op:    "replace",
+				Path:  "active",
+				Value: false,
+			},
+		},
+	})
+}
+
 // Delete will delete the user given the user id
 func (a UsersAPI) Delete(userID string) error {
 	userPath := fmt.Sprintf("/preview/scim/v2/Users/%v", userID)
@@ -95,3 +95,35 @@ func TestUsersFilter(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, users, 0)
 }
+
+func TestUsersFilter_Pagination(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?filter=active%20eq%20true",
+			Response: UserList{
+				TotalResults: 3,
+				Resources: []ScimUser{
+					{UserName: "a"},
+					{UserName: "b"},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/preview/scim/v2/Users?filter=active%20eq%20true&startIndex=3",
+			Response: UserList{
+				TotalResults: 3,
+				StartIndex:   3,
+				Resources: []ScimUser{
+					{UserName: "c"},
+				},
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		users, err := NewUsersAPI(ctx, client).Filter("active eq true")
+		require.NoError(t, err)
+		require.Len(t, users, 3)
+		assert.Equal(t, "c", users[2].UserName)
+	})
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/compute"
 	"github.com/databrickslabs/terraform-provider-databricks/exporter"
 	"github.com/databrickslabs/terraform-provider-databricks/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
@@ -32,4 +35,8 @@ https://registry.terraform.io/providers/databrickslabs/databricks/latest/docs
 
 `, common.Version())
 	plugin.Serve(&plugin.ServeOpts{ProviderFunc: provider.DatabricksProvider})
+	// Terraform kills provider process shortly after requesting the shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	compute.DestroyIdleContexts(ctx)
 }
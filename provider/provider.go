@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/databrickslabs/terraform-provider-databricks/access"
 	"github.com/databrickslabs/terraform-provider-databricks/common"
@@ -26,13 +27,20 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_aws_crossaccount_policy": access.DataAwsCrossAccountPolicy(),
 			"databricks_aws_assume_role_policy":  access.DataAwsAssumeRolePolicy(),
 			"databricks_aws_bucket_policy":       access.DataAwsBucketPolicy(),
+			"databricks_cluster":                 compute.DataSourceCluster(),
+			"databricks_cluster_events":          compute.DataSourceClusterEvents(),
+			"databricks_cluster_policy":          compute.DataSourceClusterPolicy(),
+			"databricks_clusters":                compute.DataSourceClusters(),
 			"databricks_current_user":            identity.DataSourceCurrentUser(),
 			"databricks_dbfs_file":               storage.DataSourceDBFSFile(),
 			"databricks_dbfs_file_paths":         storage.DataSourceDBFSFilePaths(),
 			"databricks_group":                   identity.DataSourceGroup(),
+			"databricks_jobs":                    compute.DataSourceJobs(),
 			"databricks_node_type":               compute.DataSourceNodeType(),
 			"databricks_notebook":                workspace.DataSourceNotebook(),
 			"databricks_notebook_paths":          workspace.DataSourceNotebookPaths(),
+			"databricks_secret":                  access.DataSourceSecret(),
+			"databricks_secret_scopes":           access.DataSourceSecretScopes(),
 			"databricks_spark_version":           compute.DataSourceSparkVersion(),
 			"databricks_user":                    identity.DataSourceUser(),
 			"databricks_zones":                   compute.DataSourceClusterZones(),
@@ -75,6 +83,7 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_azure_adls_gen2_mount": storage.ResourceAzureAdlsGen2Mount(),
 			"databricks_azure_blob_mount":      storage.ResourceAzureBlobMount(),
 			"databricks_dbfs_file":             storage.ResourceDBFSFile(),
+			"databricks_mount":                 storage.ResourceMount(),
 
 			"databricks_sql_dashboard":     sqlanalytics.ResourceDashboard(),
 			"databricks_sql_endpoint":      sqlanalytics.ResourceSQLEndpoint(),
@@ -82,10 +91,12 @@ func DatabricksProvider() *schema.Provider {
 			"databricks_sql_visualization": sqlanalytics.ResourceVisualization(),
 			"databricks_sql_widget":        sqlanalytics.ResourceWidget(),
 
-			"databricks_directory":          workspace.ResourceDirectory(),
-			"databricks_global_init_script": workspace.ResourceGlobalInitScript(),
-			"databricks_notebook":           workspace.ResourceNotebook(),
-			"databricks_workspace_conf":     workspace.ResourceWorkspaceConf(),
+			"databricks_directory":                workspace.ResourceDirectory(),
+			"databricks_global_init_script":       workspace.ResourceGlobalInitScript(),
+			"databricks_notebook":                 workspace.ResourceNotebook(),
+			"databricks_notification_destination": workspace.ResourceNotificationDestination(),
+			"databricks_workspace_conf":           workspace.ResourceWorkspaceConf(),
+			"databricks_workspace_file":           workspace.ResourceWorkspaceFile(),
 		},
 		Schema: map[string]*schema.Schema{
 			"host": {
@@ -148,6 +159,31 @@ func DatabricksProvider() *schema.Provider {
 					"token",
 				},
 			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Databricks Account ID, that enables calls to account-level APIs with the same provider configuration",
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_ACCOUNT_ID", nil),
+			},
+			"auth_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Explicitly selects the authentication method, that provider should use, " +
+					"instead of trying all of them in the order of precedence",
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_AUTH_TYPE", nil),
+				ValidateFunc: validation.StringInSlice([]string{
+					"pat",
+					"basic",
+					"azure-client-secret",
+					"azure-client-certificate",
+					"azure-msi",
+					"azure-oidc",
+					"azure-cli",
+					"google-id",
+					"databricks-cli",
+				}, false),
+			},
 			"azure_workspace_resource_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -192,6 +228,23 @@ func DatabricksProvider() *schema.Provider {
 					"DATABRICKS_AZURE_CLIENT_SECRET",
 					"ARM_CLIENT_SECRET"}, nil),
 			},
+			"azure_client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PFX certificate of Azure Service Principal, when client secrets are not allowed",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"DATABRICKS_AZURE_CLIENT_CERTIFICATE_PATH",
+					"ARM_CLIENT_CERTIFICATE_PATH"}, nil),
+			},
+			"azure_client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of PFX certificate of Azure Service Principal",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"DATABRICKS_AZURE_CLIENT_CERTIFICATE_PASSWORD",
+					"ARM_CLIENT_CERTIFICATE_PASSWORD"}, nil),
+			},
 			"azure_tenant_id": {
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -200,6 +253,50 @@ func DatabricksProvider() *schema.Provider {
 					"DATABRICKS_AZURE_TENANT_ID",
 					"ARM_TENANT_ID"}, nil),
 			},
+			"azure_use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use Azure Managed Service Identity of the VM or AKS pod, where Terraform is running",
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
+			},
+			"azure_use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Exchange OIDC token of GitHub Actions or Kubernetes for AAD token via federated credentials",
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+			},
+			"azure_oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "OIDC token to exchange for AAD token",
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", nil),
+			},
+			"azure_oidc_token_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File with OIDC token, like projected service account token of Kubernetes",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"ARM_OIDC_TOKEN_FILE_PATH",
+					"AZURE_FEDERATED_TOKEN_FILE"}, nil),
+			},
+			"azure_oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL to request OIDC token from GitHub Actions",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"ARM_OIDC_REQUEST_URL",
+					"ACTIONS_ID_TOKEN_REQUEST_URL"}, nil),
+			},
+			"azure_oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Bearer token to request OIDC token from GitHub Actions",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"ARM_OIDC_REQUEST_TOKEN",
+					"ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, nil),
+			},
 			"azure_pat_token_duration_seconds": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -223,12 +320,40 @@ func DatabricksProvider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
+				ValidateFunc: validation.StringInSlice([]string{
+					"public",
+					"german",
+					"china",
+					"usgovernment",
+				}, true),
+			},
+			"http_proxy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "URL of HTTP proxy for all requests made by the provider, including Azure AD token requests. " +
+					"By default, HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.",
 			},
 			"skip_verify": {
-				Type:        schema.TypeBool,
-				Description: "Skip SSL certificate verification for HTTP calls. Use at your own risk.",
-				Optional:    true,
-				Default:     false,
+				Type:          schema.TypeBool,
+				Description:   "Skip SSL certificate verification for HTTP calls. Use at your own risk.",
+				Optional:      true,
+				Default:       false,
+				Deprecated:    "Please use insecure_skip_verify instead",
+				ConflictsWith: []string{"insecure_skip_verify"},
+			},
+			"insecure_skip_verify": {
+				Type:          schema.TypeBool,
+				Description:   "Skip SSL certificate verification for HTTP calls. Use at your own risk.",
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("DATABRICKS_INSECURE_SKIP_VERIFY", false),
+				ConflictsWith: []string{"skip_verify"},
+			},
+			"tls_ca_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "PEM file with certificates of private certificate authorities, " +
+					"that are trusted in addition to system ones",
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_TLS_CA_FILE", nil),
 			},
 			"development_mode": {
 				Type:        schema.TypeBool,
@@ -249,11 +374,69 @@ func DatabricksProvider() *schema.Provider {
 				Description: "Debug HTTP headers of requests made by the provider. Default is false. Visible only when TF_LOG=DEBUG is set",
 				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_DEBUG_HEADERS", false),
 			},
+			"partner": {
+				Optional:    true,
+				Type:        schema.TypeString,
+				Description: "Product and version of the tool built on top of this provider, like `product/1.2.3`, appended to User-Agent header",
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_PARTNER", nil),
+				ValidateFunc: validation.StringMatch(common.PartnerRegex,
+					"must be in the form of `product/version`"),
+			},
+			"default_tags": {
+				Optional:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags added to custom_tags of all clusters, instance pools and job clusters. Tags of a resource take precedence.",
+			},
 			"rate_limit": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of requests per second made to Databricks REST API by Terraform.",
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_RATE_LIMIT", common.DefaultRateLimitPerSecond),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"http_timeout_seconds": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Timeout of a single HTTP request made by the provider. Default is 60 seconds.",
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_HTTP_TIMEOUT_SECONDS", common.DefaultHTTPTimeoutSeconds),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_idle_conns_per_host": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of idle keep-alive connections to Databricks API. Default is 20.",
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_MAX_IDLE_CONNS_PER_HOST", common.DefaultMaxIdleConnsPerHost),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"poll_interval_seconds": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				Description:  "Wait between polls of long-running operations, like cluster start. By default it grows exponentially up to 10 seconds.",
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_POLL_INTERVAL_SECONDS", nil),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"audit_log_path": {
 				Optional:    true,
-				Type:        schema.TypeInt,
-				Description: "Maximum number of requests per second made to Databricks REST API by Terraform.",
-				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_RATE_LIMIT", common.DefaultRateLimitPerSecond),
+				Type:        schema.TypeString,
+				Description: "File, where JSON line about every mutating API call is appended.",
+				DefaultFunc: schema.EnvDefaultFunc("DATABRICKS_AUDIT_LOG_PATH", nil),
+			},
+			"retry_timeout_seconds": {
+				Optional: true,
+				Type:     schema.TypeInt,
+				Description: "Maximum time to retry transient errors of a single API call. " +
+					"Default is 300 seconds, 0 disables retries.",
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_RETRY_TIMEOUT_SECONDS", common.DefaultRetryTimeoutSeconds),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retries": {
+				Optional: true,
+				Type:     schema.TypeInt,
+				Description: "Maximum number of retries of transient errors for a single API call. " +
+					"Default is 30, 0 disables retries.",
+				DefaultFunc:  schema.EnvDefaultFunc("DATABRICKS_MAX_RETRIES", common.DefaultMaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 		},
 	}
@@ -314,6 +497,12 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (int
 		authsUsed["config profile"] = true
 		pc.ConfigFile = v.(string)
 	}
+	if v, ok := d.GetOk("account_id"); ok {
+		pc.AccountID = v.(string)
+	}
+	if v, ok := d.GetOk("auth_type"); ok {
+		pc.AuthType = v.(string)
+	}
 	if v, ok := d.GetOk("azure_workspace_resource_id"); ok {
 		authsUsed["azure"] = true
 		pc.AzureAuth.ResourceID = v.(string)
@@ -338,16 +527,52 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (int
 		authsUsed["azure"] = true
 		pc.AzureAuth.ClientID = v.(string)
 	}
+	if v, ok := d.GetOk("azure_client_certificate_path"); ok {
+		authsUsed["azure"] = true
+		pc.AzureAuth.ClientCertificatePath = v.(string)
+	}
+	if v, ok := d.GetOk("azure_client_certificate_password"); ok {
+		pc.AzureAuth.ClientCertificatePassword = v.(string)
+	}
 	if v, ok := d.GetOk("azure_tenant_id"); ok {
 		authsUsed["azure"] = true
 		pc.AzureAuth.TenantID = v.(string)
 	}
+	if v, ok := d.GetOk("azure_use_msi"); ok {
+		authsUsed["azure"] = true
+		pc.AzureAuth.UseMSI = v.(bool)
+	}
+	if v, ok := d.GetOk("azure_use_oidc"); ok {
+		authsUsed["azure"] = true
+		pc.AzureAuth.UseOIDC = v.(bool)
+	}
+	if v, ok := d.GetOk("azure_oidc_token"); ok {
+		pc.AzureAuth.OIDCToken = v.(string)
+	}
+	if v, ok := d.GetOk("azure_oidc_token_file_path"); ok {
+		pc.AzureAuth.OIDCTokenFilePath = v.(string)
+	}
+	if v, ok := d.GetOk("azure_oidc_request_url"); ok {
+		pc.AzureAuth.OIDCRequestURL = v.(string)
+	}
+	if v, ok := d.GetOk("azure_oidc_request_token"); ok {
+		pc.AzureAuth.OIDCRequestToken = v.(string)
+	}
 	if v, ok := d.GetOk("azure_pat_token_duration_seconds"); ok {
 		pc.AzureAuth.PATTokenDurationSeconds = v.(string)
 	}
+	if v, ok := d.GetOk("http_proxy"); ok {
+		pc.HTTPProxy = v.(string)
+	}
 	if v, ok := d.GetOk("skip_verify"); ok {
 		pc.InsecureSkipVerify = v.(bool)
 	}
+	if v, ok := d.GetOk("insecure_skip_verify"); ok {
+		pc.InsecureSkipVerify = v.(bool)
+	}
+	if v, ok := d.GetOk("tls_ca_file"); ok {
+		pc.TLSCAFile = v.(string)
+	}
 	if v, ok := d.GetOk("development_mode"); ok {
 		pc.DevelopmentMode = v.(bool)
 	}
@@ -357,9 +582,39 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (int
 	if v, ok := d.GetOk("rate_limit"); ok {
 		pc.RateLimitPerSecond = v.(int)
 	}
+	if v, ok := d.GetOk("http_timeout_seconds"); ok {
+		pc.HTTPTimeoutSeconds = v.(int)
+	}
+	if v, ok := d.GetOk("max_idle_conns_per_host"); ok {
+		pc.MaxIdleConnsPerHost = v.(int)
+	}
+	if v, ok := d.GetOk("poll_interval_seconds"); ok {
+		pc.PollIntervalSeconds = v.(int)
+	}
+	if v, ok := d.GetOk("audit_log_path"); ok {
+		pc.AuditLogPath = v.(string)
+	}
+	// explicit zero disables retries, so GetOk cannot be used here
+	if v, ok := d.GetOkExists("retry_timeout_seconds"); ok {
+		retryTimeoutSeconds := v.(int)
+		pc.RetryTimeoutSeconds = &retryTimeoutSeconds
+	}
+	if v, ok := d.GetOkExists("max_retries"); ok {
+		maxRetries := v.(int)
+		pc.MaxRetries = &maxRetries
+	}
 	if v, ok := d.GetOk("debug_headers"); ok {
 		pc.DebugHeaders = v.(bool)
 	}
+	if v, ok := d.GetOk("partner"); ok {
+		pc.Partner = v.(string)
+	}
+	if v, ok := d.GetOk("default_tags"); ok {
+		pc.DefaultTags = map[string]string{}
+		for key, value := range v.(map[string]interface{}) {
+			pc.DefaultTags[key] = value.(string)
+		}
+	}
 	if v, ok := d.GetOk("azure_use_pat_for_cli"); ok {
 		pc.AzureAuth.UsePATForCLI = v.(bool)
 	}
@@ -375,7 +630,7 @@ func configureDatabricksClient(ctx context.Context, d *schema.ResourceData) (int
 			authorizationMethodsUsed = append(authorizationMethodsUsed, name)
 		}
 	}
-	if len(authorizationMethodsUsed) > 1 {
+	if len(authorizationMethodsUsed) > 1 && pc.AuthType == "" {
 		sort.Strings(authorizationMethodsUsed)
 		return nil, diag.Errorf("More than one authorization method configured: %s",
 			strings.Join(authorizationMethodsUsed, " and "))
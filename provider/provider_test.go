@@ -33,6 +33,7 @@ type providerConfigTest struct {
 	assertHost               string
 	assertAzure              bool
 	usePATForSPN             bool
+	azureUseMSI              bool
 }
 
 func (tt providerConfigTest) rawConfig() map[string]interface{} {
@@ -79,6 +80,9 @@ func (tt providerConfigTest) rawConfig() map[string]interface{} {
 	if tt.usePATForSPN {
 		rawConfig["azure_use_pat_for_spn"] = true
 	}
+	if tt.azureUseMSI {
+		rawConfig["azure_use_msi"] = true
+	}
 	return rawConfig
 }
 
@@ -177,6 +181,17 @@ func TestProviderConfigurationOptions(t *testing.T) {
 			},
 			assertError: "More than one authorization method configured: password and token",
 		},
+		{
+			env: map[string]string{
+				"DATABRICKS_HOST":      "x",
+				"DATABRICKS_TOKEN":     "x",
+				"DATABRICKS_USERNAME":  "x",
+				"DATABRICKS_PASSWORD":  "x",
+				"DATABRICKS_AUTH_TYPE": "pat",
+			},
+			assertHost:  "https://x",
+			assertToken: "x",
+		},
 		{
 			env: map[string]string{
 				"CONFIG_FILE": "x",
@@ -207,6 +222,15 @@ func TestProviderConfigurationOptions(t *testing.T) {
 			},
 			assertError: "More than one authorization method configured: config profile and token",
 		},
+		{
+			env: map[string]string{
+				"DATABRICKS_TOKEN":     "x",
+				"DATABRICKS_AUTH_TYPE": "databricks-cli",
+				"HOME":                 "../common/testdata",
+			},
+			assertHost:  "https://dbc-XXXXXXXX-YYYY.cloud.databricks.com/",
+			assertToken: "PT0+IC9kZXYvdXJhbmRvbSA8PT0KYFZ",
+		},
 		{
 			env: map[string]string{
 				"DATABRICKS_USERNAME":       "x",
@@ -311,6 +335,28 @@ func TestProviderConfigurationOptions(t *testing.T) {
 			assertHost:   "",
 			assertToken:  "",
 		},
+		{
+			// MSI authentication doesn't need Azure CLI or client secret
+			azureWorkspaceResourceID: azResourceID,
+			azureUseMSI:              true,
+			host:                     "x",
+			env: map[string]string{
+				"PATH":         "whatever",
+				"HOME":         "../common/testdata",
+				"MSI_ENDPOINT": "http://localhost:50342/oauth2/token",
+			},
+			assertAzure: true,
+			assertHost:  "https://x",
+		},
+		{
+			azureWorkspaceResourceID: azResourceID,
+			azureUseMSI:              true,
+			token:                    "x",
+			env: map[string]string{
+				"HOME": "../common/testdata",
+			},
+			assertError: "More than one authorization method configured: azure and token",
+		},
 		{
 			env: map[string]string{
 				"HOME": "../common/testdata/corrupt",
@@ -362,30 +408,30 @@ func configureProviderAndReturnClient(t *testing.T, tt providerConfigTest) (*com
 }
 
 func TestProvider_NoHostGivesError(t *testing.T) {
-	var raw = make(map[string]interface{})
-	raw["config_file"] = "testdata/.databrickscfg"
-	raw["profile"] = "nohost"
-	p := DatabricksProvider()
-	err := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
-	assert.NotNil(t, err)
+	_, err := configureProviderAndReturnClient(t, providerConfigTest{
+		configFile: "../common/testdata/.databrickscfg",
+		profile:    "nohost",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot find host in nohost profile")
 }
 
 func TestProvider_NoTokenGivesError(t *testing.T) {
-	var raw = make(map[string]interface{})
-	raw["config_file"] = "testdata/.databrickscfg"
-	raw["profile"] = "notoken"
-	p := DatabricksProvider()
-	err := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
-	assert.NotNil(t, err)
+	_, err := configureProviderAndReturnClient(t, providerConfigTest{
+		configFile: "../common/testdata/.databrickscfg",
+		profile:    "notoken",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot find token in notoken profile")
 }
 
 func TestProvider_InvalidProfileGivesError(t *testing.T) {
-	var raw = make(map[string]interface{})
-	raw["config_file"] = "testdata/.databrickscfg"
-	raw["profile"] = "invalidhost"
-	p := DatabricksProvider()
-	err := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
-	assert.NotNil(t, err)
+	_, err := configureProviderAndReturnClient(t, providerConfigTest{
+		configFile: "../common/testdata/.databrickscfg",
+		profile:    "invalidhost",
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has no invalidhost profile configured")
 }
 
 func TestAllResourcesMustHaveImport(t *testing.T) {
@@ -397,3 +443,97 @@ func TestAllResourcesMustHaveImport(t *testing.T) {
 		}
 	}
 }
+
+func TestProvider_HTTPTimeoutSeconds(t *testing.T) {
+	defer common.CleanupEnvironment()()
+	os.Setenv("DATABRICKS_HTTP_TIMEOUT_SECONDS", "300")
+	p := DatabricksProvider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":  "https://x",
+		"token": "y",
+	}))
+	require.Len(t, diags, 0)
+	assert.Equal(t, 300, p.Meta().(*common.DatabricksClient).HTTPTimeoutSeconds)
+}
+
+func TestProvider_PollIntervalSeconds(t *testing.T) {
+	defer common.CleanupEnvironment()()
+	os.Setenv("DATABRICKS_POLL_INTERVAL_SECONDS", "15")
+	p := DatabricksProvider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":  "https://x",
+		"token": "y",
+	}))
+	require.Len(t, diags, 0)
+	assert.Equal(t, 15, p.Meta().(*common.DatabricksClient).PollIntervalSeconds)
+}
+
+func TestProvider_Partner(t *testing.T) {
+	defer common.CleanupEnvironment()()
+	os.Setenv("DATABRICKS_PARTNER", "my-tool/0.1.2")
+	p := DatabricksProvider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":  "https://x",
+		"token": "y",
+	}))
+	require.Len(t, diags, 0)
+	assert.Equal(t, "my-tool/0.1.2", p.Meta().(*common.DatabricksClient).Partner)
+
+	diags = p.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"partner": "my tool",
+	}))
+	require.True(t, diags.HasError())
+}
+
+func TestProvider_DefaultTags(t *testing.T) {
+	defer common.CleanupEnvironment()()
+	p := DatabricksProvider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":  "https://x",
+		"token": "y",
+		"default_tags": map[string]interface{}{
+			"Owner": "platform",
+		},
+	}))
+	require.Len(t, diags, 0)
+	assert.Equal(t, map[string]string{
+		"Owner": "platform",
+	}, p.Meta().(*common.DatabricksClient).DefaultTags)
+}
+
+func TestProvider_RetriesCanBeDisabled(t *testing.T) {
+	defer common.CleanupEnvironment()()
+	os.Setenv("DATABRICKS_MAX_RETRIES", "0")
+	p := DatabricksProvider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":                  "https://x",
+		"token":                 "y",
+		"retry_timeout_seconds": 0,
+	}))
+	require.Len(t, diags, 0)
+	client := p.Meta().(*common.DatabricksClient)
+	require.NotNil(t, client.RetryTimeoutSeconds)
+	assert.Equal(t, 0, *client.RetryTimeoutSeconds)
+	require.NotNil(t, client.MaxRetries)
+	assert.Equal(t, 0, *client.MaxRetries)
+}
+
+func TestProvider_RetriesDefaults(t *testing.T) {
+	defer common.CleanupEnvironment()()
+	p := DatabricksProvider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"host":  "https://x",
+		"token": "y",
+	}))
+	require.Len(t, diags, 0)
+	client := p.Meta().(*common.DatabricksClient)
+	require.NotNil(t, client.RetryTimeoutSeconds)
+	assert.Equal(t, common.DefaultRetryTimeoutSeconds, *client.RetryTimeoutSeconds)
+	require.NotNil(t, client.MaxRetries)
+	assert.Equal(t, common.DefaultMaxRetries, *client.MaxRetries)
+
+	diags = p.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"max_retries": -1,
+	}))
+	require.True(t, diags.HasError())
+}
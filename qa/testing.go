@@ -88,6 +88,8 @@ type ResourceFixture struct {
 	// new resource
 	New       bool
 	AzureAuth *common.AzureAuth
+	// tags from provider configuration
+	DefaultTags map[string]string
 }
 
 // Apply runs tests from fixture
@@ -106,6 +108,9 @@ func (f ResourceFixture) Apply(t *testing.T) (*schema.ResourceData, error) {
 	if f.AzureAuth != nil {
 		client.AzureAuth = *f.AzureAuth
 	}
+	if f.DefaultTags != nil {
+		client.DefaultTags = f.DefaultTags
+	}
 	if len(f.HCL) > 0 {
 		var out interface{}
 		// TODO: update to HCLv2 somehow, so that importer and this use the same stuff
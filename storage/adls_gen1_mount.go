@@ -36,7 +36,7 @@ func (m AzureADLSGen1Mount) Config() map[string]string {
 
 // ResourceAzureAdlsGen1Mount creates the resource
 func ResourceAzureAdlsGen1Mount() *schema.Resource {
-	return commonMountResource(AzureADLSGen1Mount{}, map[string]*schema.Schema{
+	return commonMountResource(AzureADLSGen1Mount{}, "adl", map[string]*schema.Schema{
 		"cluster_id": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -63,7 +63,6 @@ func ResourceAzureAdlsGen1Mount() *schema.Resource {
 			Optional:     true,
 			Default:      "fs.adl",
 			ValidateFunc: validation.StringInSlice([]string{"fs.adl", "dfs.adls"}, false),
-			ForceNew:     true,
 		},
 		"directory": {
 			Type:     schema.TypeString,
@@ -78,23 +77,19 @@ func ResourceAzureAdlsGen1Mount() *schema.Resource {
 			// TODO: take it from AzureAuth if not speficied
 			Type:     schema.TypeString,
 			Required: true,
-			ForceNew: true,
 		},
 		"client_id": {
 			// TODO: take it from AzureAuth if not speficied
 			Type:     schema.TypeString,
 			Required: true,
-			ForceNew: true,
 		},
 		"client_secret_scope": {
 			Type:     schema.TypeString,
 			Required: true,
-			ForceNew: true,
 		},
 		"client_secret_key": {
 			Type:     schema.TypeString,
 			Required: true,
-			ForceNew: true,
 		},
 	})
 }
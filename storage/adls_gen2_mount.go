@@ -11,11 +11,12 @@ type AzureADLSGen2Mount struct {
 	ContainerName        string `json:"container_name"`
 	StorageAccountName   string `json:"storage_account_name"`
 	Directory            string `json:"directory,omitempty"`
-	ClientID             string `json:"client_id"`
-	TenantID             string `json:"tenant_id"`
-	SecretScope          string `json:"client_secret_scope"`
-	SecretKey            string `json:"client_secret_key"`
+	ClientID             string `json:"client_id,omitempty"`
+	TenantID             string `json:"tenant_id,omitempty"`
+	SecretScope          string `json:"client_secret_scope,omitempty"`
+	SecretKey            string `json:"client_secret_key,omitempty"`
 	InitializeFileSystem bool   `json:"initialize_file_system"`
+	UsePassthrough       bool   `json:"use_passthrough"`
 }
 
 // Source returns ABFSS URI backing the mount
@@ -26,6 +27,14 @@ func (m AzureADLSGen2Mount) Source() string {
 
 // Config returns mount configurations
 func (m AzureADLSGen2Mount) Config() map[string]string {
+	if m.UsePassthrough {
+		// token of the current user is provided by the cluster with credential passthrough
+		return map[string]string{
+			"fs.azure.account.auth.type":                          "CustomAccessToken",
+			"fs.azure.account.custom.token.provider.class":        "{conf/spark.databricks.passthrough.adls.gen2.tokenProviderClassName}",
+			"fs.azure.createRemoteFileSystemDuringInitialization": fmt.Sprintf("%t", m.InitializeFileSystem),
+		}
+	}
 	return map[string]string{
 		"fs.azure.account.auth.type":                          "OAuth",
 		"fs.azure.account.oauth.provider.type":                "org.apache.hadoop.fs.azurebfs.oauth2.ClientCredsTokenProvider",
@@ -38,7 +47,7 @@ func (m AzureADLSGen2Mount) Config() map[string]string {
 
 // ResourceAzureAdlsGen2Mount creates the resource
 func ResourceAzureAdlsGen2Mount() *schema.Resource {
-	return commonMountResource(AzureADLSGen2Mount{}, map[string]*schema.Schema{
+	return commonMountResource(AzureADLSGen2Mount{}, "abfs", map[string]*schema.Schema{
 		"cluster_id": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -72,29 +81,34 @@ func ResourceAzureAdlsGen2Mount() *schema.Resource {
 			ValidateFunc: ValidateMountDirectory,
 		},
 		"tenant_id": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			AtLeastOneOf: []string{"tenant_id", "use_passthrough"},
 		},
 		"client_id": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			AtLeastOneOf: []string{"client_id", "use_passthrough"},
 		},
 		"client_secret_scope": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			AtLeastOneOf: []string{"client_secret_scope", "use_passthrough"},
 		},
 		"client_secret_key": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			AtLeastOneOf: []string{"client_secret_key", "use_passthrough"},
 		},
 		"initialize_file_system": {
 			Type:     schema.TypeBool,
-			Required: true,
-			ForceNew: true,
+			Optional: true,
+			Default:  false,
+		},
+		"use_passthrough": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
 		},
 	})
 }
@@ -77,3 +77,161 @@ func TestResourceAdlsGen2Mount_Create(t *testing.T) {
 	assert.Equal(t, "this_mount", d.Id())
 	assert.Equal(t, "abfss://e@test-adls-gen2.dfs.core.windows.net", d.Get("source"))
 }
+
+func TestResourceAdlsGen2Mount_CreateWithExtraConfigs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceAzureAdlsGen2Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"fs.azure.createRemoteFileSystemDuringInitialization":"true"`)
+				assert.Contains(t, trunc, `"fs.azure.account.oauth2.client.endpoint":"https://login.example.com/a/oauth2/token"`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://e@test-adls-gen2.dfs.core.windows.net",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id":           "this_cluster",
+			"container_name":       "e",
+			"mount_name":           "this_mount",
+			"storage_account_name": "test-adls-gen2",
+			"tenant_id":            "a",
+			"client_id":            "b",
+			"client_secret_scope":  "c",
+			"client_secret_key":    "d",
+			"extra_configs": map[string]interface{}{
+				"fs.azure.createRemoteFileSystemDuringInitialization": "true",
+				"fs.azure.account.oauth2.client.endpoint":             "https://login.example.com/a/oauth2/token",
+			},
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "abfss://e@test-adls-gen2.dfs.core.windows.net", d.Get("source"))
+}
+
+func TestResourceAdlsGen2Mount_UpdateRemountsWithRotatedSecret(t *testing.T) {
+	commands := []string{}
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceAzureAdlsGen2Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"fs.azure.account.oauth2.client.secret":dbutils.secrets.get("c", "rotated")`)
+				commands = append(commands, "mount")
+			} else if strings.Contains(trunc, "dbutils.fs.unmount(mount_point)") {
+				commands = append(commands, "unmount")
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://e@test-adls-gen2.dfs.core.windows.net",
+			}
+		},
+		InstanceState: map[string]string{
+			"cluster_id":           "this_cluster",
+			"container_name":       "e",
+			"mount_name":           "this_mount",
+			"storage_account_name": "test-adls-gen2",
+			"tenant_id":            "a",
+			"client_id":            "b",
+			"client_secret_scope":  "c",
+			"client_secret_key":    "d",
+			"source":               "abfss://e@test-adls-gen2.dfs.core.windows.net",
+		},
+		State: map[string]interface{}{
+			"cluster_id":           "this_cluster",
+			"container_name":       "e",
+			"mount_name":           "this_mount",
+			"storage_account_name": "test-adls-gen2",
+			"tenant_id":            "a",
+			"client_id":            "b",
+			"client_secret_scope":  "c",
+			"client_secret_key":    "rotated",
+		},
+		ID:     "this_mount",
+		Update: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, []string{"unmount", "mount"}, commands)
+	assert.Equal(t, "rotated", d.Get("client_secret_key"))
+}
+
+func TestResourceAdlsGen2Mount_CreatePassthrough(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=passthrough",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceAzureAdlsGen2Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"fs.azure.account.auth.type":"CustomAccessToken"`)
+				assert.Contains(t, trunc, `"fs.azure.account.custom.token.provider.class":`+
+					`spark.conf.get("spark.databricks.passthrough.adls.gen2.tokenProviderClassName")`)
+				assert.NotContains(t, trunc, "fs.azure.account.oauth2.client.secret")
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://e@test-adls-gen2.dfs.core.windows.net",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id":           "passthrough",
+			"container_name":       "e",
+			"mount_name":           "this_mount",
+			"storage_account_name": "test-adls-gen2",
+			"use_passthrough":      true,
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+}
+
+func TestResourceAdlsGen2Mount_CreateWithoutCredentials(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceAzureAdlsGen2Mount(),
+		State: map[string]interface{}{
+			"cluster_id":           "this_cluster",
+			"container_name":       "e",
+			"mount_name":           "this_mount",
+			"storage_account_name": "test-adls-gen2",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[client_id] Missing required argument. "+
+		"[client_secret_key] Missing required argument. "+
+		"[client_secret_scope] Missing required argument. "+
+		"[tenant_id] Missing required argument")
+}
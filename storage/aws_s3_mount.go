@@ -9,11 +9,18 @@ import (
 	"github.com/databrickslabs/terraform-provider-databricks/compute"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-// AWSIamMount describes the object for a aws mount using iam role
+// AWSIamMount describes the object for a aws mount using iam role or access keys from a secret scope
 type AWSIamMount struct {
 	S3BucketName string `json:"s3_bucket_name"`
+	SecretScope  string `json:"secret_scope,omitempty"`
+	AccessKeyKey string `json:"access_key_key,omitempty"`
+	SecretKeyKey string `json:"secret_key_key,omitempty"`
+
+	EncryptionType string `json:"encryption_type,omitempty"`
+	KmsKey         string `json:"kms_key,omitempty"`
 }
 
 // Source ...
@@ -23,9 +30,49 @@ func (m AWSIamMount) Source() string {
 
 // Config ...
 func (m AWSIamMount) Config() map[string]string {
-	return make(map[string]string) // return empty map so nil map does not marshal to null
+	config := s3AccessKeysConfig(m.SecretScope, m.AccessKeyKey, m.SecretKeyKey)
+	return s3EncryptionConfig(config, m.EncryptionType, m.KmsKey)
+}
+
+// s3AccessKeysConfig returns S3A credentials, that are resolved from the secret scope on the cluster
+func s3AccessKeysConfig(scope, accessKeyKey, secretKeyKey string) map[string]string {
+	config := map[string]string{} // return empty map so nil map does not marshal to null
+	if scope == "" {
+		return config
+	}
+	config["fs.s3a.access.key"] = fmt.Sprintf("{secrets/%s/%s}", scope, accessKeyKey)
+	config["fs.s3a.secret.key"] = fmt.Sprintf("{secrets/%s/%s}", scope, secretKeyKey)
+	return config
+}
+
+// s3EncryptionConfig adds server-side encryption of written objects to S3A configuration
+func s3EncryptionConfig(config map[string]string, encryptionType, kmsKey string) map[string]string {
+	switch encryptionType {
+	case "sse-s3":
+		config["fs.s3a.server-side-encryption-algorithm"] = "AES256"
+	case "sse-kms":
+		config["fs.s3a.server-side-encryption-algorithm"] = "SSE-KMS"
+		if kmsKey != "" {
+			config["fs.s3a.server-side-encryption.key"] = kmsKey
+		}
+	}
+	return config
 }
 
+// validateS3Encryption checks, that KMS key is used only with SSE-KMS encryption
+func validateS3Encryption(encryptionType, kmsKey string) error {
+	if kmsKey != "" && encryptionType != "sse-kms" {
+		return fmt.Errorf("kms_key can only be used with encryption_type = \"sse-kms\"")
+	}
+	return nil
+}
+
+// s3EncryptionTypes are supported server-side encryption types of S3 mounts
+var s3EncryptionTypes = []string{"sse-s3", "sse-kms"}
+
+// s3AccessKeysFields are the names of secret scope and its keys with AWS credentials
+var s3AccessKeysFields = []string{"secret_scope", "access_key_key", "secret_key_key"}
+
 // ResourceAWSS3Mount ...
 func ResourceAWSS3Mount() *schema.Resource {
 	tpl := AWSIamMount{}
@@ -53,15 +100,41 @@ func ResourceAWSS3Mount() *schema.Resource {
 				ForceNew: true,
 			},
 			"instance_profile": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: s3AccessKeysFields,
+			},
+			"secret_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: s3AccessKeysFields,
+			},
+			"access_key_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: s3AccessKeysFields,
+			},
+			"secret_key_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: s3AccessKeysFields,
+			},
+			"encryption_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3EncryptionTypes, false),
+			},
+			"kms_key": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
+			"extra_configs": extraConfigsSchema(),
 		},
 		SchemaVersion: 2,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Timeouts:      mountTimeouts(),
+		Importer: mountImporter(legacyMountSourceSetter("s3", map[string]string{
+			"bucket_name": "s3_bucket_name",
+		})),
 	}
 	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if err := preprocessS3Mount(ctx, d, m); err != nil {
@@ -75,6 +148,12 @@ func ResourceAWSS3Mount() *schema.Resource {
 		}
 		return mountRead(tpl, r)(ctx, d, m)
 	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessS3Mount(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return mountUpdate(tpl, r)(ctx, d, m)
+	}
 	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 		if err := preprocessS3Mount(ctx, d, m); err != nil {
 			return diag.FromErr(err)
@@ -87,8 +166,20 @@ func ResourceAWSS3Mount() *schema.Resource {
 func preprocessS3Mount(ctx context.Context, d *schema.ResourceData, m interface{}) error {
 	clusterID := d.Get("cluster_id").(string)
 	instanceProfile := d.Get("instance_profile").(string)
+	err := validateS3Encryption(d.Get("encryption_type").(string), d.Get("kms_key").(string))
+	if err != nil {
+		return err
+	}
+	if d.Get("secret_scope").(string) != "" {
+		// access keys work on any cluster, including automatically created one
+		return nil
+	}
+	if clusterID == "" && instanceProfile == "" && d.Id() != "" {
+		// existing mount could be read and unmounted on any cluster
+		return nil
+	}
 	if clusterID == "" && instanceProfile == "" {
-		return fmt.Errorf("either cluster_id or instance_profile must be specified")
+		return fmt.Errorf("either cluster_id, instance_profile or secret_scope must be specified")
 	}
 	clustersAPI := compute.NewClustersAPI(ctx, m)
 	if clusterID != "" {
@@ -62,6 +62,114 @@ func TestResourceAwsS3MountCreate(t *testing.T) {
 	assert.Equal(t, testS3BucketPath, d.Get("source"))
 }
 
+func TestResourceAwsS3MountCreate_AccessKeys(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceAWSS3Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, testS3BucketPath)
+				assert.Contains(t, trunc, `"fs.s3a.access.key":dbutils.secrets.get("aws", "access")`)
+				assert.Contains(t, trunc, `"fs.s3a.secret.key":dbutils.secrets.get("aws", "secret")`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       testS3BucketPath,
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id":     "this_cluster",
+			"mount_name":     "this_mount",
+			"s3_bucket_name": testS3BucketName,
+			"secret_scope":   "aws",
+			"access_key_key": "access",
+			"secret_key_key": "secret",
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, testS3BucketPath, d.Get("source"))
+}
+
+func TestResourceAwsS3MountCreate_SseKms(t *testing.T) {
+	_, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+					AwsAttributes: &compute.AwsAttributes{
+						InstanceProfileArn: "abc",
+					},
+				},
+			},
+		},
+		Resource: ResourceAWSS3Mount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"fs.s3a.server-side-encryption-algorithm":"SSE-KMS"`)
+				assert.Contains(t, trunc, `"fs.s3a.server-side-encryption.key":"arn:aws:kms:us-east-1:123:key/abc"`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       testS3BucketPath,
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id":      "this_cluster",
+			"mount_name":      "this_mount",
+			"s3_bucket_name":  testS3BucketName,
+			"encryption_type": "sse-kms",
+			"kms_key":         "arn:aws:kms:us-east-1:123:key/abc",
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+}
+
+func TestResourceAwsS3MountCreate_KmsKeyWithoutSseKms(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceAWSS3Mount(),
+		State: map[string]interface{}{
+			"cluster_id":      "this_cluster",
+			"mount_name":      "this_mount",
+			"s3_bucket_name":  testS3BucketName,
+			"encryption_type": "sse-s3",
+			"kms_key":         "arn:aws:kms:us-east-1:123:key/abc",
+		},
+		Create: true,
+	}.ExpectError(t, `kms_key can only be used with encryption_type = "sse-kms"`)
+}
+
+func TestResourceAwsS3MountCreate_IncompleteAccessKeys(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceAWSS3Mount(),
+		State: map[string]interface{}{
+			"cluster_id":     "this_cluster",
+			"mount_name":     "this_mount",
+			"s3_bucket_name": testS3BucketName,
+			"secret_scope":   "aws",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[secret_scope] Missing required argument")
+}
+
 func TestResourceAwsS3MountCreate_nothing_specified(t *testing.T) {
 	_, err := qa.ResourceFixture{
 		Resource: ResourceAWSS3Mount(),
@@ -71,7 +179,7 @@ func TestResourceAwsS3MountCreate_nothing_specified(t *testing.T) {
 		},
 		Create: true,
 	}.Apply(t)
-	require.EqualError(t, err, "either cluster_id or instance_profile must be specified")
+	require.EqualError(t, err, "either cluster_id, instance_profile or secret_scope must be specified")
 }
 
 func TestResourceAwsS3MountCreate_invalid_arn(t *testing.T) {
@@ -38,7 +38,7 @@ func (m AzureBlobMount) Config() map[string]string {
 
 // ResourceAzureBlobMount creates the resource
 func ResourceAzureBlobMount() *schema.Resource {
-	return commonMountResource(AzureBlobMount{}, map[string]*schema.Schema{
+	return commonMountResource(AzureBlobMount{}, "wasb", map[string]*schema.Schema{
 		"cluster_id": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -75,18 +75,15 @@ func ResourceAzureBlobMount() *schema.Resource {
 			Type:         schema.TypeString,
 			Required:     true,
 			ValidateFunc: validation.StringInSlice([]string{"SAS", "ACCESS_KEY"}, false),
-			ForceNew:     true,
 		},
 		"token_secret_scope": {
 			Type:     schema.TypeString,
 			Required: true,
-			ForceNew: true,
 		},
 		"token_secret_key": {
 			Type:      schema.TypeString,
 			Required:  true,
 			Sensitive: true,
-			ForceNew:  true,
 		},
 	})
 }
@@ -63,6 +63,47 @@ func TestResourceAzureBlobMountCreate(t *testing.T) {
 	assert.Equal(t, "wasbs://c@f.blob.core.windows.net/d", d.Get("source"))
 }
 
+func TestResourceAzureBlobMountCreate_SAS(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=b",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceAzureBlobMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, `"fs.azure.sas.c.f.blob.core.windows.net":dbutils.secrets.get("h", "g")`)
+				assert.NotContains(t, trunc, "fs.azure.account.key")
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "wasbs://c@f.blob.core.windows.net/d",
+			}
+		},
+		State: map[string]interface{}{
+			"auth_type":            "SAS",
+			"cluster_id":           "b",
+			"container_name":       "c",
+			"directory":            "/d",
+			"mount_name":           "e",
+			"storage_account_name": "f",
+			"token_secret_key":     "g",
+			"token_secret_scope":   "h",
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "wasbs://c@f.blob.core.windows.net/d", d.Get("source"))
+}
+
 func TestResourceAzureBlobMountCreate_Error(t *testing.T) {
 	d, err := qa.ResourceFixture{
 		Fixtures: []qa.HTTPFixture{
@@ -30,7 +30,9 @@ func DataSourceDBFSFile() *schema.Resource {
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			d.Set("content", base64.StdEncoding.EncodeToString(content))
+			contentBase64 := base64.StdEncoding.EncodeToString(content)
+			d.Set("content", contentBase64)
+			d.Set("content_base64", contentBase64)
 			return nil
 		},
 		Schema: map[string]*schema.Schema{
@@ -50,6 +52,10 @@ func DataSourceDBFSFile() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"content_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"file_size": {
 				Deprecated: "Rename to size?...",
 				Type:       schema.TypeInt,
@@ -41,4 +41,28 @@ func TestDataSourceFile(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "/a/b/c", d.Id())
 	assert.Equal(t, "SGVsbG8gd29ybGQK", d.Get("content"))
+	assert.Equal(t, "SGVsbG8gd29ybGQK", d.Get("content_base64"))
+}
+
+func TestDataSourceFile_TooLarge(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   "GET",
+				Resource: "/api/2.0/dbfs/get-status?path=%2Fa%2Fb%2Fc",
+				Response: FileInfo{
+					Path:     "/a/b/c",
+					FileSize: 5000000,
+				},
+			},
+		},
+		Read:        true,
+		NonWritable: true,
+		Resource:    DataSourceDBFSFile(),
+		ID:          ".",
+		State: map[string]interface{}{
+			"path":            "/a/b/c",
+			"limit_file_size": true,
+		},
+	}.ExpectError(t, "Size of /a/b/c is too large: 5000000 bytes")
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"strconv"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 )
@@ -54,8 +55,16 @@ type DbfsAPI struct {
 	context context.Context
 }
 
-// Create creates a file on DBFS
+// dbfsBlockSize is the maximum size of data in a single add-block request
+const dbfsBlockSize = 1e6
+
+// Create creates a file on DBFS. Files larger than a single block are uploaded with one
+// multipart request, as add-block appends data to the handle in the order of calls and
+// sending hundreds of blocks one after another is slow.
 func (a DbfsAPI) Create(path string, byteArr []byte, overwrite bool) (err error) {
+	if len(byteArr) > dbfsBlockSize {
+		return a.put(path, byteArr, overwrite)
+	}
 	handle, err := a.createHandle(path, overwrite)
 	if err != nil {
 		return
@@ -68,7 +77,7 @@ func (a DbfsAPI) Create(path string, byteArr []byte, overwrite bool) (err error)
 	}()
 	buffer := bytes.NewBuffer(byteArr)
 	for {
-		byteChunk := buffer.Next(1e6)
+		byteChunk := buffer.Next(dbfsBlockSize)
 		if len(byteChunk) == 0 {
 			break
 		}
@@ -81,6 +90,13 @@ func (a DbfsAPI) Create(path string, byteArr []byte, overwrite bool) (err error)
 	return
 }
 
+func (a DbfsAPI) put(path string, content []byte, overwrite bool) error {
+	return a.client.PostMultipart(a.context, "/dbfs/put", map[string]string{
+		"path":      path,
+		"overwrite": strconv.FormatBool(overwrite),
+	}, "contents", content, nil)
+}
+
 func (a DbfsAPI) createHandle(path string, overwrite bool) (int64, error) {
 	var h Handle
 	err := a.client.Post(a.context, "/dbfs/create", CreateHandle{path, overwrite}, &h)
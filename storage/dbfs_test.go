@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/databrickslabs/terraform-provider-databricks/common"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func GenString(times int) []byte {
@@ -68,3 +72,34 @@ func TestAccCreateFile(t *testing.T) {
 	assert.NoError(t, err, err)
 	assert.Len(t, items, 3)
 }
+
+func TestDbfsAPICreate_LargeFileIsPut(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), 1500000)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(
+		func(rw http.ResponseWriter, req *http.Request) {
+			requests++
+			assert.Equal(t, "/api/2.0/dbfs/put", req.URL.Path)
+			require.NoError(t, req.ParseMultipartForm(1<<20))
+			assert.Equal(t, "/large.bin", req.FormValue("path"))
+			assert.Equal(t, "true", req.FormValue("overwrite"))
+			file, _, err := req.FormFile("contents")
+			require.NoError(t, err)
+			defer file.Close()
+			uploaded, err := ioutil.ReadAll(file)
+			require.NoError(t, err)
+			assert.Equal(t, content, uploaded)
+			_, err = rw.Write([]byte(`{}`))
+			assert.NoError(t, err)
+		}))
+	defer server.Close()
+	client := &common.DatabricksClient{
+		Host:  server.URL,
+		Token: "..",
+	}
+	require.NoError(t, client.Configure())
+
+	err := NewDbfsAPI(context.Background(), client).Create("/large.bin", content, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+}
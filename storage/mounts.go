@@ -70,6 +70,8 @@ func (mp MountPoint) Mount(mo Mount) (source string, err error) {
 	}
 	b := regexp.MustCompile(`"\{secrets/([^/]+)/([^\}]+)\}"`)
 	extraConfigs = b.ReplaceAll(extraConfigs, []byte(`dbutils.secrets.get("$1", "$2")`))
+	c := regexp.MustCompile(`"\{conf/([^\}]+)\}"`)
+	extraConfigs = c.ReplaceAll(extraConfigs, []byte(`spark.conf.get("$1")`))
 	command := fmt.Sprintf(`
 		def safe_mount(mount_point, mount_source, configs):
 			for mount in dbutils.fs.mounts():
@@ -93,18 +95,142 @@ func (mp MountPoint) Mount(mo Mount) (source string, err error) {
 	return result.Text(), result.Err()
 }
 
-func commonMountResource(tpl Mount, s map[string]*schema.Schema) *schema.Resource {
-	resource := &schema.Resource{Schema: s, SchemaVersion: 2}
+// mountWithExtraConfigs overrides configuration of the mount with `extra_configs`
+type mountWithExtraConfigs struct {
+	Mount
+	extraConfigs map[string]string
+}
+
+// Config returns mount configurations, overridden by extra configs
+func (m mountWithExtraConfigs) Config() map[string]string {
+	config := map[string]string{}
+	for k, v := range m.Mount.Config() {
+		config[k] = v
+	}
+	for k, v := range m.extraConfigs {
+		config[k] = v
+	}
+	return config
+}
+
+// extraConfigsSchema allows arbitrary Hadoop configuration keys on mounts
+func extraConfigsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func commonMountResource(tpl Mount, kind string, s map[string]*schema.Schema) *schema.Resource {
+	s["extra_configs"] = extraConfigsSchema()
+	resource := &schema.Resource{
+		Schema:        s,
+		SchemaVersion: 2,
+		Timeouts:      mountTimeouts(),
+	}
 	// nolint should be a bigger context-aware refactor
 	resource.CreateContext = mountCreate(tpl, resource)
 	resource.ReadContext = mountRead(tpl, resource)
+	resource.UpdateContext = mountUpdate(tpl, resource)
 	resource.DeleteContext = mountDelete(tpl, resource)
-	resource.Importer = &schema.ResourceImporter{
-		StateContext: schema.ImportStatePassthroughContext,
-	}
+	resource.Importer = mountImporter(legacyMountSourceSetter(kind, nil))
 	return resource
 }
 
+// mountSourcePatterns recognize storage attributes from the source of the mount.
+// Named groups are the attributes of the corresponding `databricks_mount` block
+var mountSourcePatterns = []struct {
+	kind    string
+	pattern *regexp.Regexp
+}{
+	{"s3", regexp.MustCompile(`^s3a?://(?P<bucket_name>[^/]+)/?$`)},
+	{"abfs", regexp.MustCompile(`^abfss?://(?P<container_name>[^@]+)@(?P<storage_account_name>[^.]+)` +
+		`\.dfs\.core\.windows\.net(?P<directory>/.*)?$`)},
+	{"adl", regexp.MustCompile(`^adl://(?P<storage_resource_name>[^.]+)` +
+		`\.azuredatalakestore\.net(?P<directory>/.*)?$`)},
+	{"gs", regexp.MustCompile(`^gs://(?P<bucket_name>[^/]+)/?$`)},
+	{"wasb", regexp.MustCompile(`^wasbs?://(?P<container_name>[^@]+)@(?P<storage_account_name>[^.]+)` +
+		`\.blob\.core\.windows\.net(?P<directory>/.*)?$`)},
+}
+
+// parseMountSource returns kind of the storage and its attributes, or empty kind
+// for sources, that could only be mounted by URI
+func parseMountSource(source string) (string, map[string]interface{}) {
+	for _, p := range mountSourcePatterns {
+		match := p.pattern.FindStringSubmatch(source)
+		if match == nil {
+			continue
+		}
+		attrs := map[string]interface{}{}
+		for i, name := range p.pattern.SubexpNames() {
+			if name != "" && match[i] != "" {
+				attrs[name] = match[i]
+			}
+		}
+		return p.kind, attrs
+	}
+	return "", nil
+}
+
+// legacyMountSourceSetter sets attributes of cloud-specific mount resources, where
+// renames map attributes of `databricks_mount` blocks to the names in the resource
+func legacyMountSourceSetter(kind string, renames map[string]string) func(*schema.ResourceData, string) error {
+	return func(d *schema.ResourceData, source string) error {
+		actualKind, attrs := parseMountSource(source)
+		if actualKind != kind {
+			return fmt.Errorf("/mnt/%s is mounted from %s, which is not supported by this resource",
+				d.Id(), source)
+		}
+		for k, v := range attrs {
+			if renamed, ok := renames[k]; ok {
+				k = renamed
+			}
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// mountImporter reads the source of existing mount through Commands API, so that
+// mounts created by notebooks or older versions of the provider could be imported
+func mountImporter(setSource func(*schema.ResourceData, string) error) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData,
+			m interface{}) ([]*schema.ResourceData, error) {
+			client := m.(*common.DatabricksClient)
+			clusterID, err := getMountingClusterID(ctx, client, d.Get("cluster_id").(string))
+			if err != nil {
+				return nil, err
+			}
+			mp := NewMountPoint(client.CommandExecutor(ctx), d.Id(), clusterID)
+			source, err := mp.Source()
+			if err != nil {
+				return nil, err
+			}
+			if err = d.Set("mount_name", d.Id()); err != nil {
+				return nil, err
+			}
+			if err = setSource(d, source); err != nil {
+				return nil, err
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// mountTimeouts allow to wait longer for mounting cluster to start
+func mountTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(compute.DefaultProvisionTimeout),
+		Delete: schema.DefaultTimeout(compute.DefaultProvisionTimeout),
+	}
+}
+
 // NewMountPoint returns new mount point config
 func NewMountPoint(executor common.CommandExecutor, name, clusterID string) MountPoint {
 	return MountPoint{
@@ -187,6 +313,13 @@ func mountCluster(ctx context.Context, tpl interface{}, d *schema.ResourceData,
 	}
 	mountInterface := mountReflectValue.Interface()
 	mountConfig = mountInterface.(Mount)
+	if extraConfigs, ok := d.GetOk("extra_configs"); ok {
+		overrides := map[string]string{}
+		for k, v := range extraConfigs.(map[string]interface{}) {
+			overrides[k] = v.(string)
+		}
+		mountConfig = mountWithExtraConfigs{mountConfig, overrides}
+	}
 
 	name := d.Get("mount_name").(string)
 	mountPoint.name = name
@@ -243,6 +376,29 @@ func mountRead(tpl Mount, r *schema.Resource) schema.ReadContextFunc {
 	}
 }
 
+// returns update resource function, that remounts storage with changed configuration,
+// so that rotated secrets are picked up without recreating the resource
+func mountUpdate(tpl Mount, r *schema.Resource) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		mountConfig, mp, err := mountCluster(ctx, tpl, d, m, r)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		log.Printf("[INFO] Remounting %s at /mnt/%s", mountConfig.Source(), d.Id())
+		if err = mp.Delete(); err != nil {
+			return diag.FromErr(err)
+		}
+		source, err := mp.Mount(mountConfig)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("source", source); err != nil {
+			return diag.FromErr(err)
+		}
+		return readMountSource(ctx, mp, d)
+	}
+}
+
 // returns delete resource function
 func mountDelete(tpl Mount, r *schema.Resource) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -301,3 +301,189 @@ func TestDeletedMountClusterRecreates(t *testing.T) {
 		assert.Equal(t, "bcd", clusterID)
 	})
 }
+
+func TestTerminatedMountClusterIsReused(t *testing.T) {
+	qa.HTTPFixturesApply(t, []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/list",
+			Response: compute.ClusterList{
+				Clusters: []compute.ClusterInfo{
+					{
+						ClusterID:   "bcd",
+						ClusterName: "terraform-mount",
+						State:       compute.ClusterStateTerminated,
+					},
+				},
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/spark-versions",
+			Response: compute.SparkVersionsList{
+				SparkVersions: []compute.SparkVersion{
+					{
+						Version:     "7.3.x-scala2.12",
+						Description: "7.3 LTS (includes Apache Spark 3.0.1, Scala 2.12)",
+					},
+				},
+			},
+		},
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/list-node-types",
+			Response: compute.NodeTypeList{
+				NodeTypes: []compute.NodeType{
+					{
+						NodeTypeID: "Standard_F4s",
+						MemoryMB:   8192,
+						NumCores:   4,
+						NodeInstanceType: &compute.NodeInstanceType{
+							LocalDisks: 1,
+						},
+					},
+				},
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=bcd",
+			Response: compute.ClusterInfo{
+				ClusterID: "bcd",
+				State:     compute.ClusterStateTerminated,
+			},
+		},
+		{
+			Method:   "POST",
+			Resource: "/api/2.0/clusters/start",
+			ExpectedRequest: compute.ClusterID{
+				ClusterID: "bcd",
+			},
+		},
+		{
+			Method:   "GET",
+			Resource: "/api/2.0/clusters/get?cluster_id=bcd",
+			Response: compute.ClusterInfo{
+				ClusterID: "bcd",
+				State:     compute.ClusterStateRunning,
+			},
+		},
+	}, func(ctx context.Context, client *common.DatabricksClient) {
+		clusterID, err := getMountingClusterID(ctx, client, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "bcd", clusterID)
+	})
+}
+
+func TestParseMountSource(t *testing.T) {
+	tests := []struct {
+		source string
+		kind   string
+		attrs  map[string]interface{}
+	}{
+		{"s3a://bucket", "s3", map[string]interface{}{"bucket_name": "bucket"}},
+		{"abfss://c@account.dfs.core.windows.net/dir", "abfs", map[string]interface{}{
+			"container_name":       "c",
+			"storage_account_name": "account",
+			"directory":            "/dir",
+		}},
+		{"adl://resource.azuredatalakestore.net", "adl", map[string]interface{}{
+			"storage_resource_name": "resource",
+		}},
+		{"gs://bucket", "gs", map[string]interface{}{"bucket_name": "bucket"}},
+		{"wasbs://c@account.blob.core.windows.net/", "wasb", map[string]interface{}{
+			"container_name":       "c",
+			"storage_account_name": "account",
+			"directory":            "/",
+		}},
+		{"s3a://bucket/prefix", "", nil},
+		{"dbfs:/tmp", "", nil},
+	}
+	for _, tt := range tests {
+		kind, attrs := parseMountSource(tt.source)
+		assert.Equal(t, tt.kind, kind, tt.source)
+		assert.Equal(t, tt.attrs, attrs, tt.source)
+	}
+}
+
+func mountImportFixtures() []qa.HTTPFixture {
+	return []qa.HTTPFixture{
+		{
+			Method:       "GET",
+			ReuseRequest: true,
+			Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+			Response: compute.ClusterInfo{
+				State: compute.ClusterStateRunning,
+			},
+		},
+	}
+}
+
+func TestMountImporter_Generic(t *testing.T) {
+	qa.HTTPFixturesApply(t, mountImportFixtures(), func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandMock(func(commandStr string) common.CommandResults {
+			assert.Contains(t, commandStr, `"/mnt/this_mount"`)
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://c@account.dfs.core.windows.net/dir",
+			}
+		})
+		r := ResourceMount()
+		d := r.TestResourceData()
+		d.SetId("this_mount")
+		d.Set("cluster_id", "this_cluster")
+		_, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		assert.Equal(t, "this_mount", d.Get("mount_name"))
+		assert.Equal(t, "c", d.Get("abfs.0.container_name"))
+		assert.Equal(t, "account", d.Get("abfs.0.storage_account_name"))
+		assert.Equal(t, "/dir", d.Get("abfs.0.directory"))
+	})
+}
+
+func TestMountImporter_GenericUri(t *testing.T) {
+	qa.HTTPFixturesApply(t, mountImportFixtures(), func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandMock(func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "s3a://bucket/prefix",
+			}
+		})
+		r := ResourceMount()
+		d := r.TestResourceData()
+		d.SetId("this_mount")
+		d.Set("cluster_id", "this_cluster")
+		_, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		assert.Equal(t, "s3a://bucket/prefix", d.Get("uri"))
+	})
+}
+
+func TestMountImporter_Legacy(t *testing.T) {
+	qa.HTTPFixturesApply(t, mountImportFixtures(), func(ctx context.Context, client *common.DatabricksClient) {
+		client.WithCommandMock(func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "s3a://bucket",
+			}
+		})
+		r := ResourceAWSS3Mount()
+		d := r.TestResourceData()
+		d.SetId("this_mount")
+		d.Set("cluster_id", "this_cluster")
+		_, err := r.Importer.StateContext(ctx, d, client)
+		require.NoError(t, err)
+		assert.Equal(t, "bucket", d.Get("s3_bucket_name"))
+
+		r = ResourceAzureBlobMount()
+		d = r.TestResourceData()
+		d.SetId("this_mount")
+		d.Set("cluster_id", "this_cluster")
+		_, err = r.Importer.StateContext(ctx, d, client)
+		assert.EqualError(t, err, "/mnt/this_mount is mounted from s3a://bucket, "+
+			"which is not supported by this resource")
+	})
+}
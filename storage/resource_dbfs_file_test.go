@@ -1,6 +1,9 @@
 package storage
 
 import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -186,6 +189,38 @@ func TestDBFSFileCreate(t *testing.T) {
 	}
 }
 
+func TestDBFSFileCreate_LargeContentIsUploadedInOneRequest(t *testing.T) {
+	path := "/large.bin"
+	content := bytes.Repeat([]byte("a"), 1500000)
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				// multipart/form-data body is verified in TestDbfsAPICreate_LargeFileIsPut
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/dbfs/put",
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: fmt.Sprintf("/api/2.0/dbfs/get-status?path=%s", url.PathEscape(path)),
+				Response: FileInfo{
+					Path:     path,
+					FileSize: int64(len(content)),
+				},
+			},
+		},
+		Resource: ResourceDBFSFile(),
+		Create:   true,
+		State: map[string]interface{}{
+			"content_base64": base64.StdEncoding.EncodeToString(content),
+			"path":           path,
+		},
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, path, d.Id())
+	assert.Equal(t, len(content), d.Get("file_size"))
+	assert.Equal(t, fmt.Sprintf("%x", md5.Sum(content)), d.Get("md5"))
+}
+
 func TestDBFSFileDelete(t *testing.T) {
 	path := "/abc"
 	d, err := qa.ResourceFixture{
@@ -0,0 +1,336 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/compute"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// S3IamMount describes S3 bucket, that is accessed through instance profile
+// of the mounting cluster or with access keys from a secret scope
+type S3IamMount struct {
+	BucketName      string `json:"bucket_name"`
+	InstanceProfile string `json:"instance_profile,omitempty"`
+	SecretScope     string `json:"secret_scope,omitempty"`
+	AccessKeyKey    string `json:"access_key_key,omitempty"`
+	SecretKeyKey    string `json:"secret_key_key,omitempty"`
+	EncryptionType  string `json:"encryption_type,omitempty"`
+	KmsKey          string `json:"kms_key,omitempty"`
+}
+
+// Source returns S3A URI backing the mount
+func (m S3IamMount) Source() string {
+	return fmt.Sprintf("s3a://%s", m.BucketName)
+}
+
+// Config returns mount configurations
+func (m S3IamMount) Config() map[string]string {
+	config := s3AccessKeysConfig(m.SecretScope, m.AccessKeyKey, m.SecretKeyKey)
+	return s3EncryptionConfig(config, m.EncryptionType, m.KmsKey)
+}
+
+// AzureADLSGen2MountGeneric is the `abfs` block of generic mount
+type AzureADLSGen2MountGeneric struct {
+	ContainerName        string `json:"container_name"`
+	StorageAccountName   string `json:"storage_account_name"`
+	Directory            string `json:"directory,omitempty"`
+	ClientID             string `json:"client_id,omitempty"`
+	TenantID             string `json:"tenant_id,omitempty"`
+	SecretScope          string `json:"client_secret_scope,omitempty"`
+	SecretKey            string `json:"client_secret_key,omitempty"`
+	InitializeFileSystem bool   `json:"initialize_file_system,omitempty"`
+	UsePassthrough       bool   `json:"use_passthrough,omitempty"`
+}
+
+// Source returns ABFSS URI backing the mount
+func (m AzureADLSGen2MountGeneric) Source() string {
+	return AzureADLSGen2Mount(m).Source()
+}
+
+// Config returns mount configurations
+func (m AzureADLSGen2MountGeneric) Config() map[string]string {
+	return AzureADLSGen2Mount(m).Config()
+}
+
+// AzureADLSGen1MountGeneric is the `adl` block of generic mount
+type AzureADLSGen1MountGeneric struct {
+	StorageResource string `json:"storage_resource_name"`
+	Directory       string `json:"directory,omitempty"`
+	PrefixType      string `json:"spark_conf_prefix,omitempty" tf:"default:fs.adl"`
+	ClientID        string `json:"client_id"`
+	TenantID        string `json:"tenant_id"`
+	SecretScope     string `json:"client_secret_scope"`
+	SecretKey       string `json:"client_secret_key"`
+}
+
+// Source returns ADL URI backing the mount
+func (m AzureADLSGen1MountGeneric) Source() string {
+	return AzureADLSGen1Mount(m).Source()
+}
+
+// Config returns mount configurations
+func (m AzureADLSGen1MountGeneric) Config() map[string]string {
+	return AzureADLSGen1Mount(m).Config()
+}
+
+// AzureBlobMountGeneric is the `wasb` block of generic mount
+type AzureBlobMountGeneric struct {
+	ContainerName      string `json:"container_name"`
+	StorageAccountName string `json:"storage_account_name"`
+	Directory          string `json:"directory,omitempty"`
+	AuthType           string `json:"auth_type"`
+	SecretScope        string `json:"token_secret_scope"`
+	SecretKey          string `json:"token_secret_key"`
+}
+
+// Source returns WASBS URI backing the mount
+func (m AzureBlobMountGeneric) Source() string {
+	return AzureBlobMount(m).Source()
+}
+
+// Config returns mount configurations
+func (m AzureBlobMountGeneric) Config() map[string]string {
+	return AzureBlobMount(m).Config()
+}
+
+// GSMount describes GCS bucket, that is accessed through service account of the mounting cluster
+type GSMount struct {
+	BucketName     string `json:"bucket_name"`
+	ServiceAccount string `json:"service_account,omitempty"`
+}
+
+// Source returns GS URI backing the mount
+func (m GSMount) Source() string {
+	return fmt.Sprintf("gs://%s", m.BucketName)
+}
+
+// Config returns mount configurations
+func (m GSMount) Config() map[string]string {
+	return map[string]string{}
+}
+
+// GenericMount mounts either one of supported object storages or any URI with extra configs
+type GenericMount struct {
+	URI          string            `json:"uri,omitempty"`
+	ExtraConfigs map[string]string `json:"extra_configs,omitempty"`
+
+	S3   *S3IamMount                `json:"s3,omitempty"`
+	Abfs *AzureADLSGen2MountGeneric `json:"abfs,omitempty"`
+	Adl  *AzureADLSGen1MountGeneric `json:"adl,omitempty"`
+	Gs   *GSMount                   `json:"gs,omitempty"`
+	Wasb *AzureBlobMountGeneric     `json:"wasb,omitempty"`
+}
+
+// storage returns configured object storage block or nil for plain URI mounts
+func (m GenericMount) storage() Mount {
+	switch {
+	case m.S3 != nil:
+		return *m.S3
+	case m.Abfs != nil:
+		return *m.Abfs
+	case m.Adl != nil:
+		return *m.Adl
+	case m.Gs != nil:
+		return *m.Gs
+	case m.Wasb != nil:
+		return *m.Wasb
+	}
+	return nil
+}
+
+// Source returns URI backing the mount
+func (m GenericMount) Source() string {
+	if s := m.storage(); s != nil {
+		return s.Source()
+	}
+	return m.URI
+}
+
+// Config returns mount configurations of the storage block, overridden by extra configs
+func (m GenericMount) Config() map[string]string {
+	config := map[string]string{}
+	if s := m.storage(); s != nil {
+		for k, v := range s.Config() {
+			config[k] = v
+		}
+	}
+	for k, v := range m.ExtraConfigs {
+		config[k] = v
+	}
+	return config
+}
+
+var mountSchema = common.StructToSchema(GenericMount{},
+	func(s map[string]*schema.Schema) map[string]*schema.Schema {
+		s["cluster_id"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		}
+		s["mount_name"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		}
+		s["source"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+		storages := []string{"uri", "s3", "abfs", "adl", "gs", "wasb"}
+		for _, k := range storages {
+			s[k].ExactlyOneOf = storages
+		}
+		for _, path := range [][]string{{"abfs", "directory"}, {"adl", "directory"}, {"wasb", "directory"}} {
+			if p, err := common.SchemaPath(s, path...); err == nil {
+				p.ValidateFunc = ValidateMountDirectory
+			}
+		}
+		if p, err := common.SchemaPath(s, "s3"); err == nil {
+			s3 := p.Elem.(*schema.Resource).Schema
+			accessKeys := []string{}
+			for _, field := range s3AccessKeysFields {
+				accessKeys = append(accessKeys, "s3.0."+field)
+			}
+			s3["instance_profile"].ConflictsWith = accessKeys
+			for _, field := range s3AccessKeysFields {
+				s3[field].RequiredWith = accessKeys
+			}
+			s3["encryption_type"].ValidateFunc = validation.StringInSlice(s3EncryptionTypes, false)
+		}
+		if p, err := common.SchemaPath(s, "abfs"); err == nil {
+			abfs := p.Elem.(*schema.Resource).Schema
+			for _, field := range []string{"tenant_id", "client_id", "client_secret_scope", "client_secret_key"} {
+				abfs[field].AtLeastOneOf = []string{"abfs.0." + field, "abfs.0.use_passthrough"}
+			}
+		}
+		if p, err := common.SchemaPath(s, "adl", "spark_conf_prefix"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"fs.adl", "dfs.adls"}, false)
+		}
+		if p, err := common.SchemaPath(s, "wasb", "auth_type"); err == nil {
+			p.ValidateFunc = validation.StringInSlice([]string{"SAS", "ACCESS_KEY"}, false)
+		}
+		if p, err := common.SchemaPath(s, "wasb", "token_secret_key"); err == nil {
+			p.Sensitive = true
+		}
+		// storage is remounted in place only when its configuration changes
+		for k, v := range s {
+			if k != "source" && k != "extra_configs" {
+				v.ForceNew = true
+			}
+		}
+		for _, path := range [][]string{
+			{"s3", "bucket_name"},
+			{"abfs", "container_name"}, {"abfs", "storage_account_name"}, {"abfs", "directory"},
+			{"adl", "storage_resource_name"}, {"adl", "directory"},
+			{"gs", "bucket_name"},
+			{"wasb", "container_name"}, {"wasb", "storage_account_name"}, {"wasb", "directory"},
+		} {
+			if p, err := common.SchemaPath(s, path...); err == nil {
+				p.ForceNew = true
+			}
+		}
+		return s
+	})
+
+// ResourceMount mounts S3, ADLS Gen1 & Gen2, GCS, Azure Blob Storage or any other URI
+func ResourceMount() *schema.Resource {
+	tpl := GenericMount{}
+	r := &schema.Resource{
+		Schema:   mountSchema,
+		Timeouts: mountTimeouts(),
+		Importer: mountImporter(setGenericMountSource),
+	}
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return mountCreate(tpl, r)(ctx, d, m)
+	}
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return mountRead(tpl, r)(ctx, d, m)
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return mountUpdate(tpl, r)(ctx, d, m)
+	}
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if err := preprocessGenericMount(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+		return mountDelete(tpl, r)(ctx, d, m)
+	}
+	return r
+}
+
+// setGenericMountSource sets the storage block recognized from the source or `uri` otherwise
+func setGenericMountSource(d *schema.ResourceData, source string) error {
+	kind, attrs := parseMountSource(source)
+	if kind == "" {
+		return d.Set("uri", source)
+	}
+	return d.Set(kind, []interface{}{attrs})
+}
+
+// preprocessGenericMount picks the mounting cluster with instance profile or
+// service account, that are required to access S3 or GCS buckets
+func preprocessGenericMount(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	err := validateS3Encryption(d.Get("s3.0.encryption_type").(string), d.Get("s3.0.kms_key").(string))
+	if err != nil {
+		return err
+	}
+	clustersAPI := compute.NewClustersAPI(ctx, m)
+	if instanceProfile := d.Get("s3.0.instance_profile").(string); instanceProfile != "" {
+		cluster, err := GetOrCreateMountingClusterWithInstanceProfile(clustersAPI, instanceProfile)
+		if err != nil {
+			return err
+		}
+		return d.Set("cluster_id", cluster.ClusterID)
+	}
+	if serviceAccount := d.Get("gs.0.service_account").(string); serviceAccount != "" {
+		cluster, err := getOrCreateMountingClusterWithGcpServiceAccount(clustersAPI, serviceAccount)
+		if err != nil {
+			return err
+		}
+		return d.Set("cluster_id", cluster.ClusterID)
+	}
+	return nil
+}
+
+func getOrCreateMountingClusterWithGcpServiceAccount(
+	clustersAPI compute.ClustersAPI, serviceAccount string) (compute.ClusterInfo, error) {
+	clusterName := fmt.Sprintf("terraform-mount-gcs-%s", strings.Split(serviceAccount, "@")[0])
+	return clustersAPI.GetOrCreateRunningCluster(clusterName, compute.Cluster{
+		NumWorkers:  0,
+		ClusterName: clusterName,
+		SparkVersion: clustersAPI.LatestSparkVersionOrDefault(
+			compute.SparkVersionRequest{
+				Latest:          true,
+				LongTermSupport: true,
+			}),
+		NodeTypeID: clustersAPI.GetSmallestNodeType(
+			compute.NodeTypeRequest{
+				LocalDisk: true,
+			}),
+		AutoterminationMinutes: 10,
+		GcpAttributes: &compute.GcpAttributes{
+			GoogleServiceAccount: serviceAccount,
+		},
+		SparkConf: map[string]string{
+			"spark.master":                     "local[*]",
+			"spark.databricks.cluster.profile": "singleNode",
+		},
+		CustomTags: map[string]string{
+			"ResourceClass": "SingleNode",
+		},
+	})
+}
@@ -0,0 +1,309 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/compute"
+	"github.com/databrickslabs/terraform-provider-databricks/internal"
+
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test interface compliance via compile time error
+var _ Mount = (*GenericMount)(nil)
+
+func TestGenericMount_Config(t *testing.T) {
+	m := GenericMount{
+		Abfs: &AzureADLSGen2MountGeneric{
+			ContainerName:      "e",
+			StorageAccountName: "test-adls-gen2",
+			ClientID:           "b",
+			TenantID:           "a",
+			SecretScope:        "c",
+			SecretKey:          "d",
+		},
+		ExtraConfigs: map[string]string{
+			"fs.azure.createRemoteFileSystemDuringInitialization": "true",
+		},
+	}
+	assert.Equal(t, "abfss://e@test-adls-gen2.dfs.core.windows.net", m.Source())
+	config := m.Config()
+	assert.Equal(t, "true", config["fs.azure.createRemoteFileSystemDuringInitialization"])
+	assert.Equal(t, "{secrets/c/d}", config["fs.azure.account.oauth2.client.secret"])
+}
+
+func TestResourceMountCreate_Abfs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, "abfss://e@test-adls-gen2.dfs.core.windows.net")
+				assert.Contains(t, trunc, `"fs.azure.account.oauth2.client.secret":dbutils.secrets.get("c", "d")`)
+			}
+			assert.Contains(t, trunc, "/mnt/this_mount")
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "abfss://e@test-adls-gen2.dfs.core.windows.net",
+			}
+		},
+		HCL: `
+		cluster_id = "this_cluster"
+		mount_name = "this_mount"
+		abfs {
+			container_name = "e"
+			storage_account_name = "test-adls-gen2"
+			tenant_id = "a"
+			client_id = "b"
+			client_secret_scope = "c"
+			client_secret_key = "d"
+		}`,
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "this_mount", d.Id())
+	assert.Equal(t, "abfss://e@test-adls-gen2.dfs.core.windows.net", d.Get("source"))
+}
+
+func TestResourceMountCreate_S3WithInstanceProfile(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list",
+				Response: compute.ClusterList{
+					Clusters: []compute.ClusterInfo{
+						{
+							ClusterID:   "mounting",
+							ClusterName: "terraform-mount-s3-access",
+							State:       compute.ClusterStateRunning,
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/spark-versions",
+				Response: compute.SparkVersionsList{
+					SparkVersions: []compute.SparkVersion{
+						{
+							Version:     "7.3.x-scala2.12",
+							Description: "7.3 LTS (includes Apache Spark 3.0.1, Scala 2.12)",
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/list-node-types",
+				Response: compute.NodeTypeList{
+					NodeTypes: []compute.NodeType{
+						{
+							NodeTypeID: "m5d.large",
+							MemoryMB:   8192,
+							NumCores:   2,
+							NodeInstanceType: &compute.NodeInstanceType{
+								LocalDisks: 1,
+							},
+						},
+					},
+				},
+			},
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=mounting",
+				Response: compute.ClusterInfo{
+					ClusterID: "mounting",
+					State:     compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, testS3BucketPath)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       testS3BucketPath,
+			}
+		},
+		HCL: `
+		mount_name = "this_mount"
+		s3 {
+			bucket_name = "` + testS3BucketName + `"
+			instance_profile = "arn:aws:iam::1234567:instance-profile/s3-access"
+		}`,
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "mounting", d.Get("cluster_id"))
+	assert.Equal(t, testS3BucketPath, d.Get("source"))
+}
+
+func TestResourceMountCreate_UriWithExtraConfigs(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			if strings.HasPrefix(trunc, "def safe_mount") {
+				assert.Contains(t, trunc, "gs://bucket")
+				assert.Contains(t, trunc, `"google.cloud.auth.service.account.enable":"true"`)
+			}
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "gs://bucket",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+			"uri":        "gs://bucket",
+			"extra_configs": map[string]interface{}{
+				"google.cloud.auth.service.account.enable": "true",
+			},
+		},
+		Create: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "gs://bucket", d.Get("source"))
+}
+
+func TestGenericMount_S3Config(t *testing.T) {
+	m := GenericMount{
+		S3: &S3IamMount{
+			BucketName:     "bucket",
+			SecretScope:    "aws",
+			AccessKeyKey:   "access",
+			SecretKeyKey:   "secret",
+			EncryptionType: "sse-s3",
+		},
+	}
+	assert.Equal(t, "s3a://bucket", m.Source())
+	assert.Equal(t, map[string]string{
+		"fs.s3a.access.key":                       "{secrets/aws/access}",
+		"fs.s3a.secret.key":                       "{secrets/aws/secret}",
+		"fs.s3a.server-side-encryption-algorithm": "AES256",
+	}, m.Config())
+}
+
+func TestResourceMountCreate_NoStorage(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceMount(),
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+		},
+		Create: true,
+	}.ExpectError(t, "invalid config supplied. "+
+		"[abfs] Invalid combination of arguments. "+
+		"[adl] Invalid combination of arguments. "+
+		"[gs] Invalid combination of arguments. "+
+		"[s3] Invalid combination of arguments. "+
+		"[uri] Invalid combination of arguments. "+
+		"[wasb] Invalid combination of arguments")
+}
+
+func TestResourceMountRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			return common.CommandResults{
+				ResultType: "error",
+				Summary:    "Mount not found",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+			"uri":        "gs://bucket",
+		},
+		ID:      "this_mount",
+		Read:    true,
+		Removed: true,
+	}.ApplyNoError(t)
+}
+
+func TestResourceMountDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:       "GET",
+				ReuseRequest: true,
+				Resource:     "/api/2.0/clusters/get?cluster_id=this_cluster",
+				Response: compute.ClusterInfo{
+					State: compute.ClusterStateRunning,
+				},
+			},
+		},
+		Resource: ResourceMount(),
+		CommandMock: func(commandStr string) common.CommandResults {
+			trunc := internal.TrimLeadingWhitespace(commandStr)
+			t.Logf("Received command:\n%s", trunc)
+			assert.Contains(t, trunc, "dbutils.fs.unmount(mount_point)")
+			return common.CommandResults{
+				ResultType: "text",
+				Data:       "",
+			}
+		},
+		State: map[string]interface{}{
+			"cluster_id": "this_cluster",
+			"mount_name": "this_mount",
+			"wasb": []interface{}{
+				map[string]interface{}{
+					"container_name":       "c",
+					"storage_account_name": "s",
+					"auth_type":            "ACCESS_KEY",
+					"token_secret_scope":   "x",
+					"token_secret_key":     "y",
+				},
+			},
+		},
+		ID:     "this_mount",
+		Delete: true,
+	}.Apply(t)
+	require.NoError(t, err, err)
+	assert.Equal(t, "this_mount", d.Id())
+}
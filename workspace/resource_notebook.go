@@ -37,6 +37,7 @@ const (
 	Notebook      ObjectType = "NOTEBOOK"
 	Directory     ObjectType = "DIRECTORY"
 	LibraryObject ObjectType = "LIBRARY"
+	File          ObjectType = "FILE"
 )
 
 var extMap = map[string]string{
@@ -0,0 +1,149 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SlackConfig contains the incoming webhook of a Slack channel
+type SlackConfig struct {
+	URL string `json:"url"`
+}
+
+// MicrosoftTeamsConfig contains the incoming webhook of a Microsoft Teams channel
+type MicrosoftTeamsConfig struct {
+	URL string `json:"url"`
+}
+
+// PagerDutyConfig contains the integration key of a PagerDuty service
+type PagerDutyConfig struct {
+	IntegrationKey string `json:"integration_key"`
+}
+
+// GenericWebhookConfig contains the URL and optional basic authentication of a webhook
+type GenericWebhookConfig struct {
+	URL      string `json:"url"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// NotificationDestinationConfig has exactly one of the supported destination types
+type NotificationDestinationConfig struct {
+	Slack          *SlackConfig          `json:"slack,omitempty"`
+	MicrosoftTeams *MicrosoftTeamsConfig `json:"microsoft_teams,omitempty"`
+	PagerDuty      *PagerDutyConfig      `json:"pagerduty,omitempty"`
+	GenericWebhook *GenericWebhookConfig `json:"generic_webhook,omitempty"`
+}
+
+// NotificationDestination is the workspace-level target of job notifications
+type NotificationDestination struct {
+	ID              string                         `json:"id,omitempty" tf:"computed"`
+	DisplayName     string                         `json:"display_name"`
+	DestinationType string                         `json:"destination_type,omitempty" tf:"computed"`
+	Config          *NotificationDestinationConfig `json:"config"`
+}
+
+// NewNotificationDestinationsAPI creates NotificationDestinationsAPI instance from provider meta
+func NewNotificationDestinationsAPI(ctx context.Context, m interface{}) NotificationDestinationsAPI {
+	return NotificationDestinationsAPI{
+		client:  m.(*common.DatabricksClient),
+		context: ctx,
+	}
+}
+
+// NotificationDestinationsAPI exposes the Notification Destinations API
+type NotificationDestinationsAPI struct {
+	client  *common.DatabricksClient
+	context context.Context
+}
+
+// Create creates notification destination and returns it with generated ID
+func (a NotificationDestinationsAPI) Create(nd NotificationDestination) (created NotificationDestination, err error) {
+	err = a.client.Post(a.context, "/notification-destinations", nd, &created)
+	return
+}
+
+// Read returns notification destination, where secrets of config are not disclosed
+func (a NotificationDestinationsAPI) Read(id string) (nd NotificationDestination, err error) {
+	err = a.client.Get(a.context, "/notification-destinations/"+id, nil, &nd)
+	return
+}
+
+// Update changes display name and config of notification destination
+func (a NotificationDestinationsAPI) Update(id string, nd NotificationDestination) error {
+	return a.client.Patch(a.context, "/notification-destinations/"+id, nd)
+}
+
+// Delete removes notification destination
+func (a NotificationDestinationsAPI) Delete(id string) error {
+	return a.client.Delete(a.context, "/notification-destinations/"+id, nil)
+}
+
+// ResourceNotificationDestination manages destinations of job notifications
+func ResourceNotificationDestination() *schema.Resource {
+	s := common.StructToSchema(NotificationDestination{}, func(
+		s map[string]*schema.Schema) map[string]*schema.Schema {
+		delete(s, "id")
+		destinationTypes := []string{"slack", "microsoft_teams", "pagerduty", "generic_webhook"}
+		destinationPaths := []string{}
+		for _, dt := range destinationTypes {
+			destinationPaths = append(destinationPaths, "config.0."+dt)
+		}
+		for _, dt := range destinationTypes {
+			if v, err := common.SchemaPath(s, "config", dt); err == nil {
+				// type of existing destination cannot be changed
+				v.ForceNew = true
+				v.ExactlyOneOf = destinationPaths
+			}
+		}
+		for _, secret := range [][]string{
+			{"slack", "url"},
+			{"microsoft_teams", "url"},
+			{"pagerduty", "integration_key"},
+			{"generic_webhook", "url"},
+			{"generic_webhook", "password"},
+		} {
+			if v, err := common.SchemaPath(s, "config", secret[0], secret[1]); err == nil {
+				v.Sensitive = true
+			}
+		}
+		return s
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var nd NotificationDestination
+			if err := common.DataToStructPointer(d, s, &nd); err != nil {
+				return err
+			}
+			created, err := NewNotificationDestinationsAPI(ctx, c).Create(nd)
+			if err != nil {
+				return err
+			}
+			d.SetId(created.ID)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			nd, err := NewNotificationDestinationsAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			// API never returns webhook URLs and keys, so config is kept as in state
+			nd.Config = nil
+			return common.StructToData(nd, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			var nd NotificationDestination
+			if err := common.DataToStructPointer(d, s, &nd); err != nil {
+				return err
+			}
+			return NewNotificationDestinationsAPI(ctx, c).Update(d.Id(), nd)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNotificationDestinationsAPI(ctx, c).Delete(d.Id())
+		},
+	}.ToResource()
+}
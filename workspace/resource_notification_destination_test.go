@@ -0,0 +1,192 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNotificationDestinationCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/notification-destinations",
+				ExpectedRequest: NotificationDestination{
+					DisplayName: "Data Alerts",
+					Config: &NotificationDestinationConfig{
+						Slack: &SlackConfig{
+							URL: "https://hooks.slack.com/services/abc",
+						},
+					},
+				},
+				Response: NotificationDestination{
+					ID: "abc",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "Data Alerts",
+					DestinationType: "SLACK",
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Create:   true,
+		HCL: `
+		display_name = "Data Alerts"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/abc"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+	assert.Equal(t, "SLACK", d.Get("destination_type"))
+	assert.Equal(t, "https://hooks.slack.com/services/abc", d.Get("config.0.slack.0.url"))
+}
+
+func TestResourceNotificationDestinationCreate_MultipleTypes(t *testing.T) {
+	qa.ResourceFixture{
+		Resource: ResourceNotificationDestination(),
+		Create:   true,
+		HCL: `
+		display_name = "Data Alerts"
+		config {
+			slack {
+				url = "https://hooks.slack.com/services/abc"
+			}
+			pagerduty {
+				integration_key = "def"
+			}
+		}`,
+	}.ExpectError(t, "invalid config supplied. [config.#.generic_webhook] Invalid combination of arguments. "+
+		"[config.#.microsoft_teams] Invalid combination of arguments. "+
+		"[config.#.pagerduty] Invalid combination of arguments. "+
+		"[config.#.slack] Invalid combination of arguments")
+}
+
+func TestResourceNotificationDestinationRead_KeepsSecrets(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "On-call",
+					DestinationType: "PAGERDUTY",
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Read:     true,
+		New:      true,
+		ID:       "abc",
+		HCL: `
+		display_name = "On-call"
+		config {
+			pagerduty {
+				integration_key = "def"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "On-call", d.Get("display_name"))
+	assert.Equal(t, "def", d.Get("config.0.pagerduty.0.integration_key"))
+}
+
+func TestResourceNotificationDestinationRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: common.APIErrorBody{
+					ErrorCode: "RESOURCE_DOES_NOT_EXIST",
+					Message:   "Notification destination abc does not exist",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Read:     true,
+		Removed:  true,
+		ID:       "abc",
+	}.ApplyNoError(t)
+}
+
+func TestResourceNotificationDestinationUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPatch,
+				Resource: "/api/2.0/notification-destinations/abc",
+				ExpectedRequest: NotificationDestination{
+					DisplayName: "Webhook",
+					Config: &NotificationDestinationConfig{
+						GenericWebhook: &GenericWebhookConfig{
+							URL:      "https://example.com/hook",
+							Username: "user",
+							Password: "secret",
+						},
+					},
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/notification-destinations/abc",
+				Response: NotificationDestination{
+					ID:              "abc",
+					DisplayName:     "Webhook",
+					DestinationType: "WEBHOOK",
+				},
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Update:   true,
+		ID:       "abc",
+		HCL: `
+		display_name = "Webhook"
+		config {
+			generic_webhook {
+				url = "https://example.com/hook"
+				username = "user"
+				password = "secret"
+			}
+		}`,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "Webhook", d.Get("display_name"))
+}
+
+func TestResourceNotificationDestinationDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodDelete,
+				Resource: "/api/2.0/notification-destinations/abc",
+			},
+		},
+		Resource: ResourceNotificationDestination(),
+		Delete:   true,
+		ID:       "abc",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "abc", d.Id())
+}
+
+func TestResourceNotificationDestinationSensitive(t *testing.T) {
+	p, err := common.SchemaPath(ResourceNotificationDestination().Schema,
+		"config", "generic_webhook", "password")
+	assert.NoError(t, err)
+	assert.True(t, p.Sensitive)
+}
@@ -0,0 +1,78 @@
+package workspace
+
+import (
+	"context"
+	"encoding/base64"
+	"path/filepath"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importWorkspaceFile uploads arbitrary file to the workspace as is. Format RAW is
+// used instead of AUTO, that converts files with notebook source header to notebooks
+func importWorkspaceFile(notebooksAPI NotebooksAPI, path string, content []byte) error {
+	return notebooksAPI.Create(ImportRequest{
+		Content:   base64.StdEncoding.EncodeToString(content),
+		Format:    "RAW",
+		Overwrite: true,
+		Path:      path,
+	})
+}
+
+// ResourceWorkspaceFile manages files in workspace, that are not notebooks
+func ResourceWorkspaceFile() *schema.Resource {
+	s := FileContentSchema(map[string]*schema.Schema{
+		"url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"object_id": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+	})
+	return common.Resource{
+		Schema: s,
+		Create: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			content, err := ReadContent(d)
+			if err != nil {
+				return err
+			}
+			notebooksAPI := NewNotebooksAPI(ctx, c)
+			path := d.Get("path").(string)
+			parent := filepath.ToSlash(filepath.Dir(path))
+			if parent != "/" {
+				err = notebooksAPI.Mkdirs(parent)
+				if err != nil {
+					return err
+				}
+			}
+			if err = importWorkspaceFile(notebooksAPI, path, content); err != nil {
+				return err
+			}
+			d.SetId(path)
+			return nil
+		},
+		Read: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			objectStatus, err := NewNotebooksAPI(ctx, c).Read(d.Id())
+			if err != nil {
+				return err
+			}
+			d.Set("url", c.FormatURL("#workspace", d.Id()))
+			return common.StructToData(objectStatus, s, d)
+		},
+		Update: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			content, err := ReadContent(d)
+			if err != nil {
+				return err
+			}
+			return importWorkspaceFile(NewNotebooksAPI(ctx, c), d.Id(), content)
+		},
+		Delete: func(ctx context.Context, d *schema.ResourceData, c *common.DatabricksClient) error {
+			return NewNotebooksAPI(ctx, c).Delete(d.Id(), false)
+		},
+	}.ToResource()
+}
@@ -0,0 +1,197 @@
+package workspace
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/databrickslabs/terraform-provider-databricks/common"
+	"github.com/databrickslabs/terraform-provider-databricks/qa"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceWorkspaceFileCreate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/mkdirs",
+				ExpectedRequest: map[string]string{
+					"path": "/foo",
+				},
+			},
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "YWJjCg==",
+					Path:      "/foo/requirements.txt",
+					Overwrite: true,
+					Format:    "RAW",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Ffoo%2Frequirements.txt",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/foo/requirements.txt",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"path":           "/foo/requirements.txt",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/foo/requirements.txt", d.Id())
+	assert.Equal(t, 4567, d.Get("object_id"))
+	assert.Equal(t, "0bee89b07a248e27c83fc3d5951213c1", d.Get("md5"))
+}
+
+func TestResourceWorkspaceFileCreateSource(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content: "LS0gRGF0YWJyaWNrcyBub3RlYm9vayBzb3VyY2UKU0VMRUNUIDEwKjIwC" +
+						"gotLSBDT01NQU5EIC0tLS0tLS0tLS0KClNFTEVDVCAyMCoxMDAKCi0tIE" +
+						"NPTU1BTkQgLS0tLS0tLS0tLQoKCg==",
+					Path:      "/query.sql",
+					Overwrite: true,
+					Format:    "RAW",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fquery.sql",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/query.sql",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"source": "acceptance/testdata/tf-test-sql.sql",
+			"path":   "/query.sql",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/query.sql", d.Id())
+}
+
+func TestResourceWorkspaceFileCreate_NotebookHeaderStaysFile(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "IyBEYXRhYnJpY2tzIG5vdGVib29rIHNvdXJjZQpwcmludCgxKQo=",
+					Path:      "/helper.py",
+					Overwrite: true,
+					Format:    "RAW",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Fhelper.py",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/helper.py",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"content_base64": "IyBEYXRhYnJpY2tzIG5vdGVib29rIHNvdXJjZQpwcmludCgxKQo=",
+			"path":           "/helper.py",
+		},
+		Create: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/helper.py", d.Id())
+	assert.Equal(t, 4567, d.Get("object_id"))
+}
+
+func TestResourceWorkspaceFileRead_NotFound(t *testing.T) {
+	qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Ftest%2Fconfig.json",
+				Response: common.APIErrorBody{
+					ErrorCode: "NOT_FOUND",
+					Message:   "Item not found",
+				},
+				Status: 404,
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		Read:     true,
+		Removed:  true,
+		ID:       "/test/config.json",
+	}.ApplyNoError(t)
+}
+
+func TestResourceWorkspaceFileUpdate(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:   http.MethodPost,
+				Resource: "/api/2.0/workspace/import",
+				ExpectedRequest: ImportRequest{
+					Content:   "YWJjCg==",
+					Path:      "/test/config.json",
+					Overwrite: true,
+					Format:    "RAW",
+				},
+			},
+			{
+				Method:   http.MethodGet,
+				Resource: "/api/2.0/workspace/get-status?path=%2Ftest%2Fconfig.json",
+				Response: ObjectStatus{
+					ObjectID:   4567,
+					ObjectType: File,
+					Path:       "/test/config.json",
+				},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		State: map[string]interface{}{
+			"content_base64": "YWJjCg==",
+			"path":           "/test/config.json",
+		},
+		ID:     "/test/config.json",
+		Update: true,
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/test/config.json", d.Id())
+}
+
+func TestResourceWorkspaceFileDelete(t *testing.T) {
+	d, err := qa.ResourceFixture{
+		Fixtures: []qa.HTTPFixture{
+			{
+				Method:          http.MethodPost,
+				Resource:        "/api/2.0/workspace/delete",
+				ExpectedRequest: NotebookDeleteRequest{Path: "/test/config.json", Recursive: false},
+			},
+		},
+		Resource: ResourceWorkspaceFile(),
+		Delete:   true,
+		ID:       "/test/config.json",
+	}.Apply(t)
+	assert.NoError(t, err, err)
+	assert.Equal(t, "/test/config.json", d.Id())
+}